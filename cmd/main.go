@@ -27,6 +27,9 @@ func main() {
 		checkoutport       = flag.Int("checkoutport", 8087, "checkout service port")
 		recommendationport = flag.Int("recommendationport", 8088, "recommendation service port")
 		adport             = flag.Int("adport", 8089, "ad service port")
+		reviewport         = flag.Int("reviewport", 8090, "review service port")
+		wishlistport       = flag.Int("wishlistport", 8091, "wishlist service port")
+		notificationport   = flag.Int("notificationport", 8092, "notification service port")
 	)
 	flag.Parse()
 
@@ -60,6 +63,12 @@ func main() {
 		srv = services.NewRecommendationService(*recommendationport)
 	case "ad":
 		srv = services.NewAdService(*adport)
+	case "review":
+		srv = services.NewReviewService(*reviewport)
+	case "wishlist":
+		srv = services.NewWishlistService(*wishlistport)
+	case "notification":
+		srv = services.NewNotificationService(*notificationport)
 	case "frontend":
 		srv = services.NewFrontendServer(*frontendport)
 	default:
@@ -122,7 +122,9 @@ type Ad struct {
 	// url to redirect to when an ad is clicked.
 	RedirectUrl string `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
 	// short advertisement text to display.
-	Text          string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Identifies the ad in RecordImpressions and RecordClick.
+	Id            string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -171,6 +173,357 @@ func (x *Ad) GetText() string {
 	return ""
 }
 
+func (x *Ad) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RecordImpressionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AdIds         []string               `protobuf:"bytes,2,rep,name=ad_ids,json=adIds,proto3" json:"ad_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordImpressionsRequest) Reset() {
+	*x = RecordImpressionsRequest{}
+	mi := &file_ad_ad_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordImpressionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordImpressionsRequest) ProtoMessage() {}
+
+func (x *RecordImpressionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_ad_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordImpressionsRequest.ProtoReflect.Descriptor instead.
+func (*RecordImpressionsRequest) Descriptor() ([]byte, []int) {
+	return file_ad_ad_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RecordImpressionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordImpressionsRequest) GetAdIds() []string {
+	if x != nil {
+		return x.AdIds
+	}
+	return nil
+}
+
+type RecordClickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AdId          string                 `protobuf:"bytes,2,opt,name=ad_id,json=adId,proto3" json:"ad_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordClickRequest) Reset() {
+	*x = RecordClickRequest{}
+	mi := &file_ad_ad_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordClickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordClickRequest) ProtoMessage() {}
+
+func (x *RecordClickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_ad_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordClickRequest.ProtoReflect.Descriptor instead.
+func (*RecordClickRequest) Descriptor() ([]byte, []int) {
+	return file_ad_ad_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecordClickRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordClickRequest) GetAdId() string {
+	if x != nil {
+		return x.AdId
+	}
+	return ""
+}
+
+type RecordClickResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RedirectUrl   string                 `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordClickResponse) Reset() {
+	*x = RecordClickResponse{}
+	mi := &file_ad_ad_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordClickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordClickResponse) ProtoMessage() {}
+
+func (x *RecordClickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_ad_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordClickResponse.ProtoReflect.Descriptor instead.
+func (*RecordClickResponse) Descriptor() ([]byte, []int) {
+	return file_ad_ad_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RecordClickResponse) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+type GetAdStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdStatsRequest) Reset() {
+	*x = GetAdStatsRequest{}
+	mi := &file_ad_ad_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdStatsRequest) ProtoMessage() {}
+
+func (x *GetAdStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_ad_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdStatsRequest) Descriptor() ([]byte, []int) {
+	return file_ad_ad_proto_rawDescGZIP(), []int{6}
+}
+
+// AdStat counts impressions and clicks since the service started.
+type AdStat struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ad ID for per-ad stats, or the category for per-category stats.
+	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Impressions int64  `protobuf:"varint,2,opt,name=impressions,proto3" json:"impressions,omitempty"`
+	Clicks      int64  `protobuf:"varint,3,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	// Click-through rate, clicks / impressions, or 0 with no impressions.
+	Ctr           float64 `protobuf:"fixed64,4,opt,name=ctr,proto3" json:"ctr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdStat) Reset() {
+	*x = AdStat{}
+	mi := &file_ad_ad_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdStat) ProtoMessage() {}
+
+func (x *AdStat) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_ad_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdStat.ProtoReflect.Descriptor instead.
+func (*AdStat) Descriptor() ([]byte, []int) {
+	return file_ad_ad_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AdStat) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AdStat) GetImpressions() int64 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *AdStat) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *AdStat) GetCtr() float64 {
+	if x != nil {
+		return x.Ctr
+	}
+	return 0
+}
+
+type AdStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ads   []*AdStat              `protobuf:"bytes,1,rep,name=ads,proto3" json:"ads,omitempty"`
+	// An ad in several categories counts towards each of them.
+	Categories    []*AdStat `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdStats) Reset() {
+	*x = AdStats{}
+	mi := &file_ad_ad_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdStats) ProtoMessage() {}
+
+func (x *AdStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_ad_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdStats.ProtoReflect.Descriptor instead.
+func (*AdStats) Descriptor() ([]byte, []int) {
+	return file_ad_ad_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AdStats) GetAds() []*AdStat {
+	if x != nil {
+		return x.Ads
+	}
+	return nil
+}
+
+func (x *AdStats) GetCategories() []*AdStat {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_ad_ad_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_ad_ad_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_ad_ad_proto_rawDescGZIP(), []int{9}
+}
+
 var File_ad_ad_proto protoreflect.FileDescriptor
 
 var file_ad_ad_proto_rawDesc = []byte{
@@ -182,15 +535,54 @@ var file_ad_ad_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x26, 0x0a, 0x0a, 0x41, 0x64,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x03, 0x61, 0x64, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x61, 0x64, 0x2e, 0x41, 0x64, 0x52, 0x03, 0x61,
-	0x64, 0x73, 0x22, 0x3b, 0x0a, 0x02, 0x41, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69,
+	0x64, 0x73, 0x22, 0x4b, 0x0a, 0x02, 0x41, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69,
 	0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
 	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x32,
-	0x36, 0x0a, 0x09, 0x41, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x06,
-	0x47, 0x65, 0x74, 0x41, 0x64, 0x73, 0x12, 0x0d, 0x2e, 0x61, 0x64, 0x2e, 0x41, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x64, 0x2e, 0x41, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2f, 0x61, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x4a, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x49, 0x64, 0x73, 0x22, 0x42, 0x0a, 0x12, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x61, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x49, 0x64, 0x22,
+	0x38, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x41, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66,
+	0x0a, 0x06, 0x41, 0x64, 0x53, 0x74, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x69, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6c,
+	0x69, 0x63, 0x6b, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x74, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x63, 0x74, 0x72, 0x22, 0x53, 0x0a, 0x07, 0x41, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x1c, 0x0a, 0x03, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x61, 0x64, 0x2e, 0x41, 0x64, 0x53, 0x74, 0x61, 0x74, 0x52, 0x03, 0x61, 0x64, 0x73, 0x12,
+	0x2a, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x64, 0x2e, 0x41, 0x64, 0x53, 0x74, 0x61, 0x74, 0x52,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x07, 0x0a, 0x05, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x32, 0xec, 0x01, 0x0a, 0x09, 0x41, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x41, 0x64, 0x73, 0x12, 0x0d, 0x2e, 0x61,
+	0x64, 0x2e, 0x41, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x64,
+	0x2e, 0x41, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a,
+	0x11, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x64, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x09, 0x2e, 0x61, 0x64, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x0b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x61,
+	0x64, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x64, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x32, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x15, 0x2e,
+	0x61, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x64, 0x2e, 0x41, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x22, 0x00, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f,
+	0x61, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -205,21 +597,36 @@ func file_ad_ad_proto_rawDescGZIP() []byte {
 	return file_ad_ad_proto_rawDescData
 }
 
-var file_ad_ad_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ad_ad_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_ad_ad_proto_goTypes = []any{
-	(*AdRequest)(nil),  // 0: ad.AdRequest
-	(*AdResponse)(nil), // 1: ad.AdResponse
-	(*Ad)(nil),         // 2: ad.Ad
+	(*AdRequest)(nil),                // 0: ad.AdRequest
+	(*AdResponse)(nil),               // 1: ad.AdResponse
+	(*Ad)(nil),                       // 2: ad.Ad
+	(*RecordImpressionsRequest)(nil), // 3: ad.RecordImpressionsRequest
+	(*RecordClickRequest)(nil),       // 4: ad.RecordClickRequest
+	(*RecordClickResponse)(nil),      // 5: ad.RecordClickResponse
+	(*GetAdStatsRequest)(nil),        // 6: ad.GetAdStatsRequest
+	(*AdStat)(nil),                   // 7: ad.AdStat
+	(*AdStats)(nil),                  // 8: ad.AdStats
+	(*Empty)(nil),                    // 9: ad.Empty
 }
 var file_ad_ad_proto_depIdxs = []int32{
 	2, // 0: ad.AdResponse.ads:type_name -> ad.Ad
-	0, // 1: ad.AdService.GetAds:input_type -> ad.AdRequest
-	1, // 2: ad.AdService.GetAds:output_type -> ad.AdResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	7, // 1: ad.AdStats.ads:type_name -> ad.AdStat
+	7, // 2: ad.AdStats.categories:type_name -> ad.AdStat
+	0, // 3: ad.AdService.GetAds:input_type -> ad.AdRequest
+	3, // 4: ad.AdService.RecordImpressions:input_type -> ad.RecordImpressionsRequest
+	4, // 5: ad.AdService.RecordClick:input_type -> ad.RecordClickRequest
+	6, // 6: ad.AdService.GetAdStats:input_type -> ad.GetAdStatsRequest
+	1, // 7: ad.AdService.GetAds:output_type -> ad.AdResponse
+	9, // 8: ad.AdService.RecordImpressions:output_type -> ad.Empty
+	5, // 9: ad.AdService.RecordClick:output_type -> ad.RecordClickResponse
+	8, // 10: ad.AdService.GetAdStats:output_type -> ad.AdStats
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_ad_ad_proto_init() }
@@ -233,7 +640,7 @@ func file_ad_ad_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ad_ad_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
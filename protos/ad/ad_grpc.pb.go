@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdService_GetAds_FullMethodName = "/ad.AdService/GetAds"
+	AdService_GetAds_FullMethodName            = "/ad.AdService/GetAds"
+	AdService_RecordImpressions_FullMethodName = "/ad.AdService/RecordImpressions"
+	AdService_RecordClick_FullMethodName       = "/ad.AdService/RecordClick"
+	AdService_GetAdStats_FullMethodName        = "/ad.AdService/GetAdStats"
 )
 
 // AdServiceClient is the client API for AdService service.
@@ -27,6 +30,13 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AdServiceClient interface {
 	GetAds(ctx context.Context, in *AdRequest, opts ...grpc.CallOption) (*AdResponse, error)
+	// RecordImpressions records that ads were shown to a user. Frequency
+	// caps count recorded impressions.
+	RecordImpressions(ctx context.Context, in *RecordImpressionsRequest, opts ...grpc.CallOption) (*Empty, error)
+	// RecordClick records that a user clicked an ad and returns where to
+	// send them.
+	RecordClick(ctx context.Context, in *RecordClickRequest, opts ...grpc.CallOption) (*RecordClickResponse, error)
+	GetAdStats(ctx context.Context, in *GetAdStatsRequest, opts ...grpc.CallOption) (*AdStats, error)
 }
 
 type adServiceClient struct {
@@ -47,11 +57,48 @@ func (c *adServiceClient) GetAds(ctx context.Context, in *AdRequest, opts ...grp
 	return out, nil
 }
 
+func (c *adServiceClient) RecordImpressions(ctx context.Context, in *RecordImpressionsRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, AdService_RecordImpressions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adServiceClient) RecordClick(ctx context.Context, in *RecordClickRequest, opts ...grpc.CallOption) (*RecordClickResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordClickResponse)
+	err := c.cc.Invoke(ctx, AdService_RecordClick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adServiceClient) GetAdStats(ctx context.Context, in *GetAdStatsRequest, opts ...grpc.CallOption) (*AdStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdStats)
+	err := c.cc.Invoke(ctx, AdService_GetAdStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdServiceServer is the server API for AdService service.
 // All implementations must embed UnimplementedAdServiceServer
 // for forward compatibility.
 type AdServiceServer interface {
 	GetAds(context.Context, *AdRequest) (*AdResponse, error)
+	// RecordImpressions records that ads were shown to a user. Frequency
+	// caps count recorded impressions.
+	RecordImpressions(context.Context, *RecordImpressionsRequest) (*Empty, error)
+	// RecordClick records that a user clicked an ad and returns where to
+	// send them.
+	RecordClick(context.Context, *RecordClickRequest) (*RecordClickResponse, error)
+	GetAdStats(context.Context, *GetAdStatsRequest) (*AdStats, error)
 	mustEmbedUnimplementedAdServiceServer()
 }
 
@@ -65,6 +112,15 @@ type UnimplementedAdServiceServer struct{}
 func (UnimplementedAdServiceServer) GetAds(context.Context, *AdRequest) (*AdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAds not implemented")
 }
+func (UnimplementedAdServiceServer) RecordImpressions(context.Context, *RecordImpressionsRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordImpressions not implemented")
+}
+func (UnimplementedAdServiceServer) RecordClick(context.Context, *RecordClickRequest) (*RecordClickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordClick not implemented")
+}
+func (UnimplementedAdServiceServer) GetAdStats(context.Context, *GetAdStatsRequest) (*AdStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdStats not implemented")
+}
 func (UnimplementedAdServiceServer) mustEmbedUnimplementedAdServiceServer() {}
 func (UnimplementedAdServiceServer) testEmbeddedByValue()                   {}
 
@@ -104,6 +160,60 @@ func _AdService_GetAds_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdService_RecordImpressions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordImpressionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdServiceServer).RecordImpressions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdService_RecordImpressions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdServiceServer).RecordImpressions(ctx, req.(*RecordImpressionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdService_RecordClick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordClickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdServiceServer).RecordClick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdService_RecordClick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdServiceServer).RecordClick(ctx, req.(*RecordClickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdService_GetAdStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdServiceServer).GetAdStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdService_GetAdStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdServiceServer).GetAdStats(ctx, req.(*GetAdStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdService_ServiceDesc is the grpc.ServiceDesc for AdService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +225,18 @@ var AdService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAds",
 			Handler:    _AdService_GetAds_Handler,
 		},
+		{
+			MethodName: "RecordImpressions",
+			Handler:    _AdService_RecordImpressions_Handler,
+		},
+		{
+			MethodName: "RecordClick",
+			Handler:    _AdService_RecordClick_Handler,
+		},
+		{
+			MethodName: "GetAdStats",
+			Handler:    _AdService_GetAdStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ad/ad.proto",
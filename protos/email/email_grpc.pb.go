@@ -19,14 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EmailService_SendOrderConfirmation_FullMethodName = "/email.EmailService/SendOrderConfirmation"
+	EmailService_SendOrderConfirmation_FullMethodName     = "/email.EmailService/SendOrderConfirmation"
+	EmailService_SendShippingUpdate_FullMethodName        = "/email.EmailService/SendShippingUpdate"
+	EmailService_SendPaymentFailed_FullMethodName         = "/email.EmailService/SendPaymentFailed"
+	EmailService_SendRefundIssued_FullMethodName          = "/email.EmailService/SendRefundIssued"
+	EmailService_SendAbandonedCartReminder_FullMethodName = "/email.EmailService/SendAbandonedCartReminder"
+	EmailService_SendProductAlert_FullMethodName          = "/email.EmailService/SendProductAlert"
+	EmailService_PreviewEmail_FullMethodName              = "/email.EmailService/PreviewEmail"
+	EmailService_GetQueueStatus_FullMethodName            = "/email.EmailService/GetQueueStatus"
 )
 
 // EmailServiceClient is the client API for EmailService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EmailServiceClient interface {
+	// SendOrderConfirmation queues the confirmation and returns once it is
+	// accepted; delivery happens in the background.
 	SendOrderConfirmation(ctx context.Context, in *SendOrderConfirmationRequest, opts ...grpc.CallOption) (*Empty, error)
+	SendShippingUpdate(ctx context.Context, in *SendShippingUpdateRequest, opts ...grpc.CallOption) (*Empty, error)
+	SendPaymentFailed(ctx context.Context, in *SendPaymentFailedRequest, opts ...grpc.CallOption) (*Empty, error)
+	SendRefundIssued(ctx context.Context, in *SendRefundIssuedRequest, opts ...grpc.CallOption) (*Empty, error)
+	SendAbandonedCartReminder(ctx context.Context, in *SendAbandonedCartReminderRequest, opts ...grpc.CallOption) (*Empty, error)
+	SendProductAlert(ctx context.Context, in *SendProductAlertRequest, opts ...grpc.CallOption) (*Empty, error)
+	// PreviewEmail renders a template with sample data without sending it.
+	PreviewEmail(ctx context.Context, in *PreviewEmailRequest, opts ...grpc.CallOption) (*EmailPreview, error)
+	GetQueueStatus(ctx context.Context, in *GetQueueStatusRequest, opts ...grpc.CallOption) (*EmailQueueStatus, error)
 }
 
 type emailServiceClient struct {
@@ -47,11 +64,91 @@ func (c *emailServiceClient) SendOrderConfirmation(ctx context.Context, in *Send
 	return out, nil
 }
 
+func (c *emailServiceClient) SendShippingUpdate(ctx context.Context, in *SendShippingUpdateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, EmailService_SendShippingUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) SendPaymentFailed(ctx context.Context, in *SendPaymentFailedRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, EmailService_SendPaymentFailed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) SendRefundIssued(ctx context.Context, in *SendRefundIssuedRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, EmailService_SendRefundIssued_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) SendAbandonedCartReminder(ctx context.Context, in *SendAbandonedCartReminderRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, EmailService_SendAbandonedCartReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) SendProductAlert(ctx context.Context, in *SendProductAlertRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, EmailService_SendProductAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) PreviewEmail(ctx context.Context, in *PreviewEmailRequest, opts ...grpc.CallOption) (*EmailPreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmailPreview)
+	err := c.cc.Invoke(ctx, EmailService_PreviewEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailServiceClient) GetQueueStatus(ctx context.Context, in *GetQueueStatusRequest, opts ...grpc.CallOption) (*EmailQueueStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmailQueueStatus)
+	err := c.cc.Invoke(ctx, EmailService_GetQueueStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EmailServiceServer is the server API for EmailService service.
 // All implementations must embed UnimplementedEmailServiceServer
 // for forward compatibility.
 type EmailServiceServer interface {
+	// SendOrderConfirmation queues the confirmation and returns once it is
+	// accepted; delivery happens in the background.
 	SendOrderConfirmation(context.Context, *SendOrderConfirmationRequest) (*Empty, error)
+	SendShippingUpdate(context.Context, *SendShippingUpdateRequest) (*Empty, error)
+	SendPaymentFailed(context.Context, *SendPaymentFailedRequest) (*Empty, error)
+	SendRefundIssued(context.Context, *SendRefundIssuedRequest) (*Empty, error)
+	SendAbandonedCartReminder(context.Context, *SendAbandonedCartReminderRequest) (*Empty, error)
+	SendProductAlert(context.Context, *SendProductAlertRequest) (*Empty, error)
+	// PreviewEmail renders a template with sample data without sending it.
+	PreviewEmail(context.Context, *PreviewEmailRequest) (*EmailPreview, error)
+	GetQueueStatus(context.Context, *GetQueueStatusRequest) (*EmailQueueStatus, error)
 	mustEmbedUnimplementedEmailServiceServer()
 }
 
@@ -65,6 +162,27 @@ type UnimplementedEmailServiceServer struct{}
 func (UnimplementedEmailServiceServer) SendOrderConfirmation(context.Context, *SendOrderConfirmationRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendOrderConfirmation not implemented")
 }
+func (UnimplementedEmailServiceServer) SendShippingUpdate(context.Context, *SendShippingUpdateRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendShippingUpdate not implemented")
+}
+func (UnimplementedEmailServiceServer) SendPaymentFailed(context.Context, *SendPaymentFailedRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPaymentFailed not implemented")
+}
+func (UnimplementedEmailServiceServer) SendRefundIssued(context.Context, *SendRefundIssuedRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendRefundIssued not implemented")
+}
+func (UnimplementedEmailServiceServer) SendAbandonedCartReminder(context.Context, *SendAbandonedCartReminderRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAbandonedCartReminder not implemented")
+}
+func (UnimplementedEmailServiceServer) SendProductAlert(context.Context, *SendProductAlertRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendProductAlert not implemented")
+}
+func (UnimplementedEmailServiceServer) PreviewEmail(context.Context, *PreviewEmailRequest) (*EmailPreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewEmail not implemented")
+}
+func (UnimplementedEmailServiceServer) GetQueueStatus(context.Context, *GetQueueStatusRequest) (*EmailQueueStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueStatus not implemented")
+}
 func (UnimplementedEmailServiceServer) mustEmbedUnimplementedEmailServiceServer() {}
 func (UnimplementedEmailServiceServer) testEmbeddedByValue()                      {}
 
@@ -104,6 +222,132 @@ func _EmailService_SendOrderConfirmation_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EmailService_SendShippingUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendShippingUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).SendShippingUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_SendShippingUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).SendShippingUpdate(ctx, req.(*SendShippingUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_SendPaymentFailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPaymentFailedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).SendPaymentFailed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_SendPaymentFailed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).SendPaymentFailed(ctx, req.(*SendPaymentFailedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_SendRefundIssued_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRefundIssuedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).SendRefundIssued(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_SendRefundIssued_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).SendRefundIssued(ctx, req.(*SendRefundIssuedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_SendAbandonedCartReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendAbandonedCartReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).SendAbandonedCartReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_SendAbandonedCartReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).SendAbandonedCartReminder(ctx, req.(*SendAbandonedCartReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_SendProductAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendProductAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).SendProductAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_SendProductAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).SendProductAlert(ctx, req.(*SendProductAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_PreviewEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).PreviewEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_PreviewEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).PreviewEmail(ctx, req.(*PreviewEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailService_GetQueueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).GetQueueStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_GetQueueStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).GetQueueStatus(ctx, req.(*GetQueueStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EmailService_ServiceDesc is the grpc.ServiceDesc for EmailService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +359,34 @@ var EmailService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendOrderConfirmation",
 			Handler:    _EmailService_SendOrderConfirmation_Handler,
 		},
+		{
+			MethodName: "SendShippingUpdate",
+			Handler:    _EmailService_SendShippingUpdate_Handler,
+		},
+		{
+			MethodName: "SendPaymentFailed",
+			Handler:    _EmailService_SendPaymentFailed_Handler,
+		},
+		{
+			MethodName: "SendRefundIssued",
+			Handler:    _EmailService_SendRefundIssued_Handler,
+		},
+		{
+			MethodName: "SendAbandonedCartReminder",
+			Handler:    _EmailService_SendAbandonedCartReminder_Handler,
+		},
+		{
+			MethodName: "SendProductAlert",
+			Handler:    _EmailService_SendProductAlert_Handler,
+		},
+		{
+			MethodName: "PreviewEmail",
+			Handler:    _EmailService_PreviewEmail_Handler,
+		},
+		{
+			MethodName: "GetQueueStatus",
+			Handler:    _EmailService_GetQueueStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "email/email.proto",
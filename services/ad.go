@@ -6,11 +6,16 @@ import (
 	"log"
 	"math/rand"
 	"net"
+	"os"
+	"sync"
+	"time"
 
 	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -19,16 +24,35 @@ const (
 
 // NewAdService returns a new server for the AdService
 func NewAdService(port int) *AdService {
+	data, err := os.ReadFile(adsFilePath)
+	if err != nil {
+		log.Fatalf("Failed to read file: %v", err)
+	}
+	inv, err := newAdInventory(data)
+	if err != nil {
+		log.Fatalf("Failed to parse ads: %v", err)
+	}
 	return &AdService{
-		port: port,
-		ads:  createAdsMap(),
+		port:   port,
+		ads:    inv,
+		capper: newFrequencyCapper(inv),
+		stats:  newAdStats(),
+		rng:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		now:    time.Now,
 	}
 }
 
 // AdService implements the AdService
 type AdService struct {
-	port int
-	ads  map[string]*pb.Ad
+	port   int
+	ads    *adInventory
+	capper *frequencyCapper
+	stats  *adStats
+
+	rngMu sync.Mutex
+	rng   *rand.Rand
+	now   func() time.Time
+
 	pb.AdServiceServer
 }
 
@@ -48,74 +72,73 @@ func (s *AdService) Run() error {
 	return srv.Serve(lis)
 }
 
-// GetAds returns a list of ads based on the context keys
+// GetAds returns up to maxAdsToServe distinct ads for the context keys,
+// falling back to any ad if none match. Ads are picked at random by weight
+// from those running now that the user has not hit the frequency cap for.
+// Serving an ad does not count towards its cap; RecordImpressions does.
 func (s *AdService) GetAds(ctx context.Context, req *pb.AdRequest) (*pb.AdResponse, error) {
 	log.Printf("GetAds request with context_keys = %v", req.GetContextKeys())
 
-	var allAds []*pb.Ad
-	keywords := req.GetContextKeys()
-
-	if len(keywords) > 0 {
-		for _, kw := range keywords {
-			allAds = append(allAds, s.getAdsByCategory(kw)...)
-		}
-		if len(allAds) == 0 {
-			// Serve random ads
-			allAds = s.getRandomAds()
-		}
-	} else {
-		allAds = s.getRandomAds()
+	now := s.now()
+	eligible := s.eligible(s.ads.candidates(req.GetContextKeys()), req.GetUserId(), now)
+	if len(eligible) == 0 && len(req.GetContextKeys()) > 0 {
+		eligible = s.eligible(s.ads.candidates(nil), req.GetUserId(), now)
 	}
 
+	s.rngMu.Lock()
+	picked := sampleAds(s.rng, eligible, maxAdsToServe)
+	s.rngMu.Unlock()
+
+	ads := make([]*pb.Ad, len(picked))
+	for i, c := range picked {
+		ads[i] = c.ad
+	}
 	return &pb.AdResponse{
-		Ads: allAds,
+		Ads: ads,
 	}, nil
 }
 
-func (s *AdService) getAdsByCategory(category string) []*pb.Ad {
-	if adInstance, ok := s.ads[category]; ok {
-		return []*pb.Ad{adInstance}
+// RecordImpressions records that the user was shown ads.
+func (s *AdService) RecordImpressions(ctx context.Context, req *pb.RecordImpressionsRequest) (*pb.Empty, error) {
+	ads := make([]*adCreative, len(req.GetAdIds()))
+	for i, id := range req.GetAdIds() {
+		c := s.ads.byID[id]
+		if c == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown ad %q", id)
+		}
+		ads[i] = c
 	}
-	return nil
+	s.capper.record(req.GetUserId(), ads, s.now())
+	s.stats.recordImpressions(ads)
+	return &pb.Empty{}, nil
 }
 
-func (s *AdService) getRandomAds() []*pb.Ad {
-	ads := make([]*pb.Ad, maxAdsToServe)
-	vals := make([]*pb.Ad, 0, len(s.ads))
-	for _, ad := range s.ads {
-		vals = append(vals, ad)
+// RecordClick records that the user clicked an ad and returns its redirect
+// URL.
+func (s *AdService) RecordClick(ctx context.Context, req *pb.RecordClickRequest) (*pb.RecordClickResponse, error) {
+	c := s.ads.byID[req.GetAdId()]
+	if c == nil {
+		return nil, status.Errorf(codes.NotFound, "unknown ad %q", req.GetAdId())
 	}
-	for i := 0; i < maxAdsToServe; i++ {
-		ads[i] = vals[rand.Intn(len(vals))]
-	}
-	return ads
+	log.Printf("RecordClick ad_id = %s", c.id)
+	s.stats.recordClick(c)
+	return &pb.RecordClickResponse{RedirectUrl: c.ad.GetRedirectUrl()}, nil
+}
+
+// GetAdStats returns impressions, clicks and click-through rates per ad and
+// per category.
+func (s *AdService) GetAdStats(ctx context.Context, req *pb.GetAdStatsRequest) (*pb.AdStats, error) {
+	return s.stats.report(s.ads), nil
 }
 
-func createAdsMap() map[string]*pb.Ad {
-	return map[string]*pb.Ad{
-		"hair": {
-			RedirectUrl: "/product/2ZYFJ3GM2N",
-			Text:        "Hairdryer for sale. 50% off.",
-		},
-		"clothing": {
-			RedirectUrl: "/product/66VCHSJNUP",
-			Text:        "Tank top for sale. 20% off.",
-		},
-		"accessories": {
-			RedirectUrl: "/product/1YMWWN1N4O",
-			Text:        "Watch for sale. Buy one, get second kit for free",
-		},
-		"footwear": {
-			RedirectUrl: "/product/L9ECAV7KIM",
-			Text:        "Loafers for sale. Buy one, get second one for free",
-		},
-		"decor": {
-			RedirectUrl: "/product/0PUK6V6EV0",
-			Text:        "Candle holder for sale. 30% off.",
-		},
-		"kitchen": {
-			RedirectUrl: "/product/9SIQT8TOJO",
-			Text:        "Bamboo glass jar for sale. 10% off.",
-		},
+// eligible filters ads down to those running at now and not capped for
+// userID.
+func (s *AdService) eligible(ads []*adCreative, userID string, now time.Time) []*adCreative {
+	var out []*adCreative
+	for _, c := range ads {
+		if c.active(now) && !s.capper.capped(userID, c, now) {
+			out = append(out, c)
+		}
 	}
+	return out
 }
@@ -0,0 +1,265 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+const adsFilePath = "data/ads.json"
+
+// adsConfig is the on-disk format of adsFilePath.
+type adsConfig struct {
+	// FrequencyCap applies to ads without a cap of their own.
+	FrequencyCap *frequencyCapConfig `json:"frequencyCap"`
+	Ads          []adConfig          `json:"ads"`
+}
+
+type frequencyCapConfig struct {
+	// Impressions is how many times one user may be shown the ad per Window.
+	Impressions int    `json:"impressions"`
+	Window      string `json:"window"`
+}
+
+type adConfig struct {
+	ID          string   `json:"id"`
+	Categories  []string `json:"categories"`
+	RedirectURL string   `json:"redirectUrl"`
+	Text        string   `json:"text"`
+	// Weight is how often the ad is picked relative to the other candidates.
+	Weight float64 `json:"weight"`
+	// Start and End bound when the ad runs, as RFC 3339 times. Either may be
+	// omitted.
+	Start        string              `json:"start"`
+	End          string              `json:"end"`
+	FrequencyCap *frequencyCapConfig `json:"frequencyCap"`
+}
+
+// adCreative is a parsed adConfig.
+type adCreative struct {
+	id         string
+	categories []string
+	ad         *pb.Ad
+	weight     float64
+	start, end time.Time
+	// capImpressions is zero if the ad is not frequency capped.
+	capImpressions int
+	capWindow      time.Duration
+}
+
+// active reports whether the ad runs at t.
+func (c *adCreative) active(t time.Time) bool {
+	return (c.start.IsZero() || !t.Before(c.start)) && (c.end.IsZero() || t.Before(c.end))
+}
+
+// adInventory holds the ads that can be served.
+type adInventory struct {
+	ads        []*adCreative
+	byID       map[string]*adCreative
+	byCategory map[string][]*adCreative
+}
+
+// newAdInventory parses and validates ad data.
+func newAdInventory(data []byte) (*adInventory, error) {
+	var cfg adsConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	defaultImpressions, defaultWindow, err := parseFrequencyCap(cfg.FrequencyCap)
+	if err != nil {
+		return nil, fmt.Errorf("frequencyCap: %w", err)
+	}
+
+	inv := &adInventory{byID: map[string]*adCreative{}, byCategory: map[string][]*adCreative{}}
+	seen := map[string]bool{}
+	for _, a := range cfg.Ads {
+		if a.ID == "" || seen[a.ID] {
+			return nil, fmt.Errorf("ad ids must be unique and non-empty, got %q", a.ID)
+		}
+		seen[a.ID] = true
+		if a.RedirectURL == "" || a.Text == "" {
+			return nil, fmt.Errorf("ad %s: redirectUrl and text are required", a.ID)
+		}
+		if a.Weight <= 0 {
+			return nil, fmt.Errorf("ad %s: weight must be positive", a.ID)
+		}
+		c := &adCreative{
+			id:             a.ID,
+			categories:     a.Categories,
+			ad:             &pb.Ad{Id: a.ID, RedirectUrl: a.RedirectURL, Text: a.Text},
+			weight:         a.Weight,
+			capImpressions: defaultImpressions,
+			capWindow:      defaultWindow,
+		}
+		if c.start, err = parseAdTime(a.Start); err != nil {
+			return nil, fmt.Errorf("ad %s: start: %w", a.ID, err)
+		}
+		if c.end, err = parseAdTime(a.End); err != nil {
+			return nil, fmt.Errorf("ad %s: end: %w", a.ID, err)
+		}
+		if !c.start.IsZero() && !c.end.IsZero() && !c.start.Before(c.end) {
+			return nil, fmt.Errorf("ad %s: start must be before end", a.ID)
+		}
+		if a.FrequencyCap != nil {
+			if c.capImpressions, c.capWindow, err = parseFrequencyCap(a.FrequencyCap); err != nil {
+				return nil, fmt.Errorf("ad %s: frequencyCap: %w", a.ID, err)
+			}
+		}
+
+		inv.ads = append(inv.ads, c)
+		inv.byID[c.id] = c
+		for _, category := range a.Categories {
+			inv.byCategory[category] = append(inv.byCategory[category], c)
+		}
+	}
+	return inv, nil
+}
+
+func parseAdTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+func parseFrequencyCap(cfg *frequencyCapConfig) (int, time.Duration, error) {
+	if cfg == nil || cfg.Impressions == 0 {
+		return 0, 0, nil
+	}
+	window, err := time.ParseDuration(cfg.Window)
+	if err != nil {
+		return 0, 0, err
+	}
+	if cfg.Impressions < 0 || window <= 0 {
+		return 0, 0, fmt.Errorf("impressions and window must be positive")
+	}
+	return cfg.Impressions, window, nil
+}
+
+// candidates returns the distinct ads in any of categories, or every ad if
+// categories is empty.
+func (inv *adInventory) candidates(categories []string) []*adCreative {
+	if len(categories) == 0 {
+		return inv.ads
+	}
+	var out []*adCreative
+	seen := map[string]bool{}
+	for _, category := range categories {
+		for _, c := range inv.byCategory[category] {
+			if !seen[c.id] {
+				seen[c.id] = true
+				out = append(out, c)
+			}
+		}
+	}
+	return out
+}
+
+// sampleAds picks up to n of ads at random without replacement, each with
+// probability proportional to its weight.
+func sampleAds(rng *rand.Rand, ads []*adCreative, n int) []*adCreative {
+	// Efraimidis-Spirakis: the n largest u^(1/w) form a weighted sample.
+	type keyed struct {
+		ad  *adCreative
+		key float64
+	}
+	keys := make([]keyed, len(ads))
+	for i, c := range ads {
+		keys[i] = keyed{c, math.Pow(rng.Float64(), 1/c.weight)}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].key > keys[j].key })
+
+	n = min(n, len(keys))
+	out := make([]*adCreative, n)
+	for i := range out {
+		out[i] = keys[i].ad
+	}
+	return out
+}
+
+// frequencyCapper remembers when each user was shown each capped ad.
+type frequencyCapper struct {
+	mu sync.Mutex
+	// shown[userID][adID] holds impression times within the ad's window,
+	// oldest first.
+	shown map[string]map[string][]time.Time
+	// retain is the longest cap window.
+	retain    time.Duration
+	lastPrune time.Time
+}
+
+func newFrequencyCapper(inv *adInventory) *frequencyCapper {
+	f := &frequencyCapper{shown: map[string]map[string][]time.Time{}}
+	for _, c := range inv.ads {
+		f.retain = max(f.retain, c.capWindow)
+	}
+	return f
+}
+
+// capped reports whether userID has already seen c as often as its cap
+// allows.
+func (f *frequencyCapper) capped(userID string, c *adCreative, now time.Time) bool {
+	if userID == "" || c.capImpressions == 0 {
+		return false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.recent(userID, c, now)) >= c.capImpressions
+}
+
+// record notes that userID was shown ads at now.
+func (f *frequencyCapper) record(userID string, ads []*adCreative, now time.Time) {
+	if userID == "" {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, c := range ads {
+		if c.capImpressions == 0 {
+			continue
+		}
+		if f.shown[userID] == nil {
+			f.shown[userID] = map[string][]time.Time{}
+		}
+		f.shown[userID][c.id] = append(f.recent(userID, c, now), now)
+	}
+	f.prune(now)
+}
+
+// recent returns the impressions of c by userID within c's window. f.mu
+// must be held.
+func (f *frequencyCapper) recent(userID string, c *adCreative, now time.Time) []time.Time {
+	times := f.shown[userID][c.id]
+	cutoff := now.Add(-c.capWindow)
+	i := sort.Search(len(times), func(i int) bool { return times[i].After(cutoff) })
+	return times[i:]
+}
+
+// prune drops users whose impressions have all aged out of every window, at
+// most once a minute, so that one-off visitors are not remembered forever.
+// f.mu must be held.
+func (f *frequencyCapper) prune(now time.Time) {
+	if now.Sub(f.lastPrune) < time.Minute {
+		return
+	}
+	f.lastPrune = now
+	cutoff := now.Add(-f.retain)
+	for userID, ads := range f.shown {
+		stale := true
+		for _, times := range ads {
+			if len(times) > 0 && times[len(times)-1].After(cutoff) {
+				stale = false
+				break
+			}
+		}
+		if stale {
+			delete(f.shown, userID)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"sort"
+	"sync"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// adStats counts impressions and clicks per ad since the service started.
+type adStats struct {
+	mu          sync.Mutex
+	impressions map[string]int64
+	clicks      map[string]int64
+}
+
+func newAdStats() *adStats {
+	return &adStats{impressions: map[string]int64{}, clicks: map[string]int64{}}
+}
+
+func (s *adStats) recordImpressions(ads []*adCreative) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range ads {
+		s.impressions[c.id]++
+	}
+}
+
+func (s *adStats) recordClick(c *adCreative) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clicks[c.id]++
+}
+
+// report returns the counts for every ad in inv, in inventory order, and
+// for every category, sorted by name.
+func (s *adStats) report(inv *adInventory) *pb.AdStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := &pb.AdStats{}
+	type totals struct{ impressions, clicks int64 }
+	byCategory := map[string]*totals{}
+	for _, c := range inv.ads {
+		impressions, clicks := s.impressions[c.id], s.clicks[c.id]
+		out.Ads = append(out.Ads, newAdStat(c.id, impressions, clicks))
+		for _, category := range c.categories {
+			t := byCategory[category]
+			if t == nil {
+				t = &totals{}
+				byCategory[category] = t
+			}
+			t.impressions += impressions
+			t.clicks += clicks
+		}
+	}
+
+	categories := make([]string, 0, len(byCategory))
+	for category := range byCategory {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	for _, category := range categories {
+		t := byCategory[category]
+		out.Categories = append(out.Categories, newAdStat(category, t.impressions, t.clicks))
+	}
+	return out
+}
+
+func newAdStat(key string, impressions, clicks int64) *pb.AdStat {
+	stat := &pb.AdStat{Key: key, Impressions: impressions, Clicks: clicks}
+	if impressions > 0 {
+		stat.Ctr = float64(clicks) / float64(impressions)
+	}
+	return stat
+}
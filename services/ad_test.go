@@ -0,0 +1,81 @@
+package services
+
+import (
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// TestAdFrequencyCapPerSession checks that the frontend identifies the
+// browser to the ad service, so that an ad capped at one impression is not
+// shown twice in the same session but is still shown to other sessions.
+func TestAdFrequencyCapPerSession(t *testing.T) {
+	inv, err := newAdInventory([]byte(`{
+		"frequencyCap": {"impressions": 1, "window": "1h"},
+		"ads": [{"id": "tank-top-sale", "categories": ["clothing"], "redirectUrl": "/product/TANKTOP", "text": "Tank top for sale.", "weight": 1}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ads := &AdService{
+		ads:    inv,
+		capper: newFrequencyCapper(inv),
+		stats:  newAdStats(),
+		rng:    rand.New(rand.NewSource(1)),
+		now:    time.Now,
+	}
+	catalog := &fakeCatalog{products: []*pb.Product{
+		{Id: "TANKTOP", Name: "Tank Top", PriceUsd: usd(18, 990000000), Categories: []string{"clothing"}},
+	}}
+	// Reviews and recommendations are not needed; an empty server fails
+	// them, which the product page tolerates.
+	none := dialServer(t, func(*grpc.Server) {})
+	fe := &frontendServer{
+		productCatalogSvcConn: dialServer(t, func(s *grpc.Server) { pb.RegisterProductCatalogServiceServer(s, catalog) }),
+		cartSvcConn:           dialServer(t, func(s *grpc.Server) { pb.RegisterCartServiceServer(s, newFakeCart()) }),
+		currencySvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterCurrencyServiceServer(s, fakeCurrency{}) }),
+		adSvcConn:             dialServer(t, func(s *grpc.Server) { pb.RegisterAdServiceServer(s, ads) }),
+		reviewSvcConn:         none,
+		recommendationSvcConn: none,
+	}
+	srv := httptest.NewServer(fe.handler())
+	defer srv.Close()
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	showsAd := func(client *http.Client) bool {
+		t.Helper()
+		resp, err := client.Get(srv.URL + "/product/TANKTOP")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("GET /product/TANKTOP: status %d\n%s", resp.StatusCode, body)
+		}
+		return strings.Contains(string(body), "Tank top for sale.")
+	}
+
+	browser := &http.Client{Jar: jar}
+	if !showsAd(browser) {
+		t.Fatal("first visit shows no ad")
+	}
+	if showsAd(browser) {
+		t.Error("capped ad shown twice in the same session")
+	}
+	if !showsAd(&http.Client{}) {
+		t.Error("another session's impression capped this one")
+	}
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// cardNetwork describes the number and CVV format of a card brand.
+type cardNetwork struct {
+	name    string
+	lengths []int
+	cvvLen  int
+}
+
+var (
+	networkVisa       = &cardNetwork{name: "Visa", lengths: []int{13, 16, 19}, cvvLen: 3}
+	networkMasterCard = &cardNetwork{name: "MasterCard", lengths: []int{16}, cvvLen: 3}
+	networkAmex       = &cardNetwork{name: "American Express", lengths: []int{15}, cvvLen: 4}
+	networkDiscover   = &cardNetwork{name: "Discover", lengths: []int{16, 17, 18, 19}, cvvLen: 3}
+)
+
+// binRange maps the inclusive range [lo, hi] of the first len(lo) digits of a
+// card number to its network.
+type binRange struct {
+	lo, hi  string
+	network *cardNetwork
+}
+
+// binRanges lists the accepted issuer identification number ranges. Longer
+// prefixes come first so that they win over shorter overlapping ones.
+var binRanges = []binRange{
+	{"622126", "622925", networkDiscover},
+	{"2221", "2720", networkMasterCard},
+	{"6011", "6011", networkDiscover},
+	{"644", "649", networkDiscover},
+	{"34", "34", networkAmex},
+	{"37", "37", networkAmex},
+	{"51", "55", networkMasterCard},
+	{"65", "65", networkDiscover},
+	{"4", "4", networkVisa},
+}
+
+// detectNetwork returns the network a card number belongs to, or nil if its
+// BIN is not in an accepted range.
+func detectNetwork(number string) *cardNetwork {
+	for _, r := range binRanges {
+		if len(number) < len(r.lo) {
+			continue
+		}
+		prefix := number[:len(r.lo)]
+		if r.lo <= prefix && prefix <= r.hi {
+			return r.network
+		}
+	}
+	return nil
+}
+
+// luhnValid reports whether number, which must contain only digits, passes
+// the Luhn checksum.
+func luhnValid(number string) bool {
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// normalizeCardNumber strips the spaces and dashes people type between
+// digit groups.
+func normalizeCardNumber(number string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(number)
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// cardCVV returns the card's CVV digits. A legacy numeric CVV has lost its
+// leading zeros, so it is padded back to the network's CVV length; network
+// may be nil if it is unknown, in which case three digits are assumed. It
+// returns "" if neither field is set; a legacy CVV of 0 is
+// indistinguishable from a missing one, so "000" must be sent as digits.
+func cardCVV(card *pb.CreditCardInfo, network *cardNetwork) string {
+	if digits := card.GetCreditCardCvvDigits(); digits != "" {
+		return digits
+	}
+	cvv := card.GetCreditCardCvv()
+	if cvv <= 0 {
+		return ""
+	}
+	n := 3
+	if network != nil {
+		n = network.cvvLen
+	}
+	return fmt.Sprintf("%0*d", n, cvv)
+}
+
+// cardExpired reports whether a card expiring in month/year is no longer
+// valid at now. Cards are valid through the last day of their expiration
+// month. Two-digit years are taken to be in the 2000s.
+func cardExpired(month, year int, now time.Time) bool {
+	if year < 100 {
+		year += 2000
+	}
+	firstInvalid := time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, now.Location())
+	return !now.Before(firstInvalid)
+}
+
+// validateCard checks the card number, CVV and expiry date and returns the
+// card's network.
+func validateCard(card *pb.CreditCardInfo, now time.Time) (*cardNetwork, error) {
+	number := normalizeCardNumber(card.GetCreditCardNumber())
+	if !isDigits(number) {
+		return nil, InvalidCreditCardErr{Field: "credit_card_number", Reason: "card number must contain only digits"}
+	}
+
+	network := detectNetwork(number)
+	if network == nil {
+		return nil, UnacceptedCreditCardErr{}
+	}
+
+	validLength := false
+	for _, l := range network.lengths {
+		if len(number) == l {
+			validLength = true
+			break
+		}
+	}
+	if !validLength {
+		return nil, InvalidCreditCardErr{Field: "credit_card_number", Reason: "card number has the wrong length for " + network.name}
+	}
+	if !luhnValid(number) {
+		return nil, InvalidCreditCardErr{Field: "credit_card_number", Reason: "card number fails checksum"}
+	}
+
+	if cvv := cardCVV(card, network); !isDigits(cvv) || len(cvv) != network.cvvLen {
+		return nil, InvalidCreditCardErr{Field: "credit_card_cvv", Reason: "CVV has the wrong length for " + network.name}
+	}
+
+	month := int(card.GetCreditCardExpirationMonth())
+	if month < 1 || month > 12 {
+		return nil, InvalidCreditCardErr{Field: "credit_card_expiration_month", Reason: "expiration month must be between 1 and 12"}
+	}
+	if card.GetCreditCardExpirationYear() <= 0 {
+		return nil, InvalidCreditCardErr{Field: "credit_card_expiration_year", Reason: "expiration year is missing"}
+	}
+	if cardExpired(month, int(card.GetCreditCardExpirationYear()), now) {
+		return nil, ExpiredCreditCardErr{}
+	}
+
+	return network, nil
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// luhnNumber pads prefix with zeros to length digits and appends the Luhn
+// check digit.
+func luhnNumber(prefix string, length int) string {
+	body := prefix + strings.Repeat("0", length-1-len(prefix))
+	for d := byte('0'); d <= '9'; d++ {
+		if n := body + string(d); luhnValid(n) {
+			return n
+		}
+	}
+	panic("unreachable")
+}
+
+func TestDetectNetwork(t *testing.T) {
+	for _, tc := range []struct {
+		number string
+		want   *cardNetwork
+	}{
+		{"4111111111111111", networkVisa},
+		{"5100000000000000", networkMasterCard},
+		{"5500000000000000", networkMasterCard},
+		{"5600000000000000", nil},
+		{"2220990000000000", nil},
+		{"2221000000000000", networkMasterCard},
+		{"2720990000000000", networkMasterCard},
+		{"2721000000000000", nil},
+		{"340000000000000", networkAmex},
+		{"370000000000000", networkAmex},
+		{"350000000000000", nil},
+		{"6011000000000000", networkDiscover},
+		{"6012000000000000", nil},
+		{"6221250000000000", nil},
+		{"6221260000000000", networkDiscover},
+		{"6229250000000000", networkDiscover},
+		{"6229260000000000", nil},
+		{"6440000000000000", networkDiscover},
+		{"6490000000000000", networkDiscover},
+		{"6500000000000000", networkDiscover},
+		{"4", networkVisa},
+		{"", nil},
+	} {
+		if got := detectNetwork(tc.number); got != tc.want {
+			t.Errorf("detectNetwork(%q) = %v, want %v", tc.number, got, tc.want)
+		}
+	}
+}
+
+func TestLuhnValid(t *testing.T) {
+	for _, tc := range []struct {
+		number string
+		want   bool
+	}{
+		{"4111111111111111", true},
+		{"4111111111111112", false},
+		{"4432801561520454", true},
+		{"378282246310005", true},
+		{"378282246310006", false},
+		{"0", true},
+		{"18", true},
+		{"81", false},
+	} {
+		if got := luhnValid(tc.number); got != tc.want {
+			t.Errorf("luhnValid(%q) = %v, want %v", tc.number, got, tc.want)
+		}
+	}
+}
+
+func TestCardExpired(t *testing.T) {
+	for _, tc := range []struct {
+		month, year int
+		now         time.Time
+		want        bool
+	}{
+		{2, 2028, time.Date(2028, 2, 29, 23, 59, 59, 0, time.UTC), false},
+		{2, 2028, time.Date(2028, 3, 1, 0, 0, 0, 0, time.UTC), true},
+		{4, 2027, time.Date(2027, 4, 30, 12, 0, 0, 0, time.UTC), false},
+		{4, 2027, time.Date(2027, 5, 1, 0, 0, 0, 0, time.UTC), true},
+		{12, 2026, time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC), false},
+		{12, 2026, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{12, 26, time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), false},
+		{11, 26, time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC), true},
+	} {
+		if got := cardExpired(tc.month, tc.year, tc.now); got != tc.want {
+			t.Errorf("cardExpired(%d, %d, %v) = %v, want %v", tc.month, tc.year, tc.now, got, tc.want)
+		}
+	}
+}
+
+func TestValidateCard(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	card := func(number string, cvv int32, cvvDigits string) *pb.CreditCardInfo {
+		return &pb.CreditCardInfo{
+			CreditCardNumber:          number,
+			CreditCardCvv:             cvv,
+			CreditCardCvvDigits:       cvvDigits,
+			CreditCardExpirationMonth: 10,
+			CreditCardExpirationYear:  2026,
+		}
+	}
+	for _, tc := range []struct {
+		name      string
+		card      *pb.CreditCardInfo
+		want      *cardNetwork
+		wantField string // the field InvalidCreditCardErr names, or "unaccepted" or "expired"
+	}{
+		{"visa", card("4432-8015-6152-0454", 672, ""), networkVisa, ""},
+		{"visa 13 digits", card(luhnNumber("4", 13), 123, ""), networkVisa, ""},
+		{"mastercard 2-series low", card(luhnNumber("2221", 16), 0, "042"), networkMasterCard, ""},
+		{"mastercard 2-series high", card(luhnNumber("2720", 16), 0, "042"), networkMasterCard, ""},
+		{"mastercard wrong length", card(luhnNumber("51", 15), 123, ""), nil, "credit_card_number"},
+		{"discover 622126", card(luhnNumber("622126", 16), 123, ""), networkDiscover, ""},
+		{"discover 622925", card(luhnNumber("622925", 19), 123, ""), networkDiscover, ""},
+		{"below discover range", card(luhnNumber("622125", 16), 123, ""), nil, "unaccepted"},
+		{"above discover range", card(luhnNumber("622926", 16), 123, ""), nil, "unaccepted"},
+		{"bad checksum", card("4111111111111112", 123, ""), nil, "credit_card_number"},
+		{"not digits", card("4111x11111111111", 123, ""), nil, "credit_card_number"},
+		{"amex 4-digit cvv", card("378282246310005", 1234, ""), networkAmex, ""},
+		{"amex 3-digit cvv", card("378282246310005", 0, "123"), nil, "credit_card_cvv"},
+		{"amex legacy cvv with leading zero", card("378282246310005", 123, ""), networkAmex, ""},
+		{"visa 4-digit cvv", card("4111111111111111", 0, "1234"), nil, "credit_card_cvv"},
+		{"visa legacy 4-digit cvv", card("4111111111111111", 1234, ""), nil, "credit_card_cvv"},
+		{"visa 2-digit cvv", card("4111111111111111", 0, "42"), nil, "credit_card_cvv"},
+		{"visa cvv with leading zeros", card("4111111111111111", 0, "007"), networkVisa, ""},
+		{"visa without cvv", card("4111111111111111", 0, ""), nil, "credit_card_cvv"},
+		{"visa cvv of zeros", card("4111111111111111", 0, "000"), networkVisa, ""},
+		{"visa legacy cvv with leading zeros", card("4111111111111111", 7, ""), networkVisa, ""},
+		{"visa negative cvv", card("4111111111111111", -1, ""), nil, "credit_card_cvv"},
+		{"visa cvv not digits", card("4111111111111111", 0, "12a"), nil, "credit_card_cvv"},
+		{"expired", &pb.CreditCardInfo{CreditCardNumber: "4111111111111111", CreditCardCvvDigits: "123",
+			CreditCardExpirationMonth: 9, CreditCardExpirationYear: 2026}, nil, "expired"},
+		{"bad month", &pb.CreditCardInfo{CreditCardNumber: "4111111111111111", CreditCardCvvDigits: "123",
+			CreditCardExpirationMonth: 13, CreditCardExpirationYear: 2026}, nil, "credit_card_expiration_month"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := validateCard(tc.card, now)
+			var field string
+			switch e := err.(type) {
+			case nil:
+			case InvalidCreditCardErr:
+				field = e.Field
+			case UnacceptedCreditCardErr:
+				field = "unaccepted"
+			case ExpiredCreditCardErr:
+				field = "expired"
+			default:
+				t.Fatalf("unexpected error %T: %v", err, err)
+			}
+			if got != tc.want || field != tc.wantField {
+				t.Errorf("validateCard = %v, %v; want %v, %q", got, err, tc.want, tc.wantField)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// fakeShipping quotes two service levels at flat rates.
+type fakeShipping struct {
+	pb.UnimplementedShippingServiceServer
+}
+
+func (fakeShipping) GetQuote(_ context.Context, req *pb.GetQuoteRequest) (*pb.GetQuoteResponse, error) {
+	options := []*pb.ShippingOption{
+		{ServiceLevel: "standard", Name: "Standard", CostUsd: usd(5, 990000000), EstimatedDeliveryDate: "2026-10-21"},
+		{ServiceLevel: "express", Name: "Express", CostUsd: usd(12, 990000000), EstimatedDeliveryDate: "2026-10-20"},
+	}
+	return &pb.GetQuoteResponse{CostUsd: options[0].GetCostUsd(), Options: options}, nil
+}
+
+// TestCartPage adds items to the cart through the frontend and checks that
+// the cart page prices them, offers every quoted service level and can be
+// emptied.
+func TestCartPage(t *testing.T) {
+	catalog := &fakeCatalog{products: []*pb.Product{
+		{Id: "SUNGLASSES", Name: "Sunglasses", Picture: "/static/img/sunglasses.jpg", PriceUsd: usd(19, 990000000)},
+		{Id: "TANKTOP", Name: "Tank Top", Picture: "/static/img/tank.jpg", PriceUsd: usd(18, 990000000),
+			Variants: []*pb.ProductVariant{{Sku: "TANKTOP-M", Attributes: []*pb.VariantAttribute{{Name: "Size", Value: "M"}}}}},
+	}}
+	fe := &frontendServer{
+		productCatalogSvcConn: dialServer(t, func(s *grpc.Server) { pb.RegisterProductCatalogServiceServer(s, catalog) }),
+		cartSvcConn:           dialServer(t, func(s *grpc.Server) { pb.RegisterCartServiceServer(s, newFakeCart()) }),
+		currencySvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterCurrencyServiceServer(s, fakeCurrency{}) }),
+		shippingSvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterShippingServiceServer(s, fakeShipping{}) }),
+		recommendationSvcConn: dialServer(t, func(*grpc.Server) {}),
+	}
+	srv := httptest.NewServer(fe.handler())
+	defer srv.Close()
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Jar: jar}
+	post := func(path string, form url.Values) string {
+		t.Helper()
+		resp, err := client.PostForm(srv.URL+path, form)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("POST %s: status %d\n%s", path, resp.StatusCode, body)
+		}
+		return string(body)
+	}
+
+	post("/cart", url.Values{"product_id": {"SUNGLASSES"}, "quantity": {"2"}})
+	body := post("/cart", url.Values{"product_id": {"TANKTOP"}, "sku": {"TANKTOP-M"}, "quantity": {"1"}})
+	for _, want := range []string{
+		"Cart (3)",
+		"$39.98", // two pairs of sunglasses
+		"$18.99", // one tank top
+		`<option value="standard">`,
+		"Express ($12.99, arrives by 2026-10-20)",
+		"$64.96", // the items and standard shipping
+		`name="sku" value="TANKTOP-M"`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("cart page lacks %q:\n%s", want, body)
+		}
+	}
+
+	if body := post("/cart/empty", nil); !strings.Contains(body, "Your shopping cart is empty!") {
+		t.Errorf("cart not emptied:\n%s", body)
+	}
+}
@@ -7,11 +7,13 @@ import (
 	"net"
 	"os"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+	"github.com/deskchen/online-boutique-grpc/services/redact"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/opentracing/opentracing-go"
@@ -64,6 +66,9 @@ type CheckoutService struct {
 
 	paymentSvcAddr string
 	paymentSvcConn *grpc.ClientConn
+
+	recommendationSvcAddr string
+	recommendationSvcConn *grpc.ClientConn
 }
 
 // Run starts the server
@@ -76,6 +81,7 @@ func (cs *CheckoutService) Run() error {
 	mustMapEnv(&cs.currencySvcAddr, "CURRENCY_SERVICE_ADDR")
 	mustMapEnv(&cs.emailSvcAddr, "EMAIL_SERVICE_ADDR")
 	mustMapEnv(&cs.paymentSvcAddr, "PAYMENT_SERVICE_ADDR")
+	mustMapEnv(&cs.recommendationSvcAddr, "RECOMMENDATION_SERVICE_ADDR")
 
 	mustConnGRPC(ctx, &cs.shippingSvcConn, cs.shippingSvcAddr)
 	mustConnGRPC(ctx, &cs.productCatalogSvcConn, cs.productCatalogSvcAddr)
@@ -83,9 +89,13 @@ func (cs *CheckoutService) Run() error {
 	mustConnGRPC(ctx, &cs.currencySvcConn, cs.currencySvcAddr)
 	mustConnGRPC(ctx, &cs.emailSvcConn, cs.emailSvcAddr)
 	mustConnGRPC(ctx, &cs.paymentSvcConn, cs.paymentSvcAddr)
+	mustConnGRPC(ctx, &cs.recommendationSvcConn, cs.recommendationSvcAddr)
 
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer())),
+		grpc.ChainUnaryInterceptor(
+			otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer()),
+			redact.UnaryServerInterceptor(),
+		),
 	}
 	srv := grpc.NewServer(opts...)
 	pb.RegisterCheckoutServiceServer(srv, cs)
@@ -100,17 +110,34 @@ func (cs *CheckoutService) Run() error {
 
 // PlaceOrder processes an order placement request
 func (cs *CheckoutService) PlaceOrder(ctx context.Context, req *pb.PlaceOrderRequest) (*pb.PlaceOrderResponse, error) {
-	log.Printf("[PlaceOrder] user_id=%q user_currency=%q", req.UserId, req.UserCurrency)
+	redact.Logf("[PlaceOrder] %v", req)
 
 	orderID, err := uuid.NewUUID()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate order uuid")
 	}
 
-	prep, err := cs.prepareOrderItemsAndShippingQuoteFromCart(ctx, req.UserId, req.UserCurrency, req.Address)
+	serviceLevel := req.GetShippingServiceLevel()
+	if serviceLevel == "" {
+		serviceLevel = defaultServiceLevel
+	}
+
+	// Reject addresses that cannot be shipped to before anything is
+	// authorized, and use the normalized form from here on.
+	address, err := cs.validateAddress(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	prep, err := cs.prepareOrderItemsAndShippingQuoteFromCart(ctx, req.UserId, req.UserCurrency, address, serviceLevel)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	// An empty cart would otherwise be charged for shipping, only to be
+	// refunded when there is nothing to ship.
+	if len(prep.orderItems) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "cart is empty")
+	}
 
 	total := pb.Money{CurrencyCode: req.UserCurrency,
 		Units: 0,
@@ -121,31 +148,60 @@ func (cs *CheckoutService) PlaceOrder(ctx context.Context, req *pb.PlaceOrderReq
 		total = *Must(Sum(&total, multPrice))
 	}
 
-	txID, err := cs.chargeCard(ctx, &total, req.CreditCard)
+	// Authorize, then capture before shipping, so that nothing ships
+	// unpaid. A failed capture releases the hold; a failed shipment
+	// refunds the payment.
+	txID, err := cs.authorizeCard(ctx, &total, req.CreditCard)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to charge card: %+v", err)
+		// Keep PaymentService's status code so callers can tell a declined
+		// or invalid card apart from an internal failure.
+		code := status.Code(err)
+		if code == codes.Unknown {
+			code = codes.Internal
+		}
+		if mailErr := cs.sendPaymentFailed(ctx, req.Email, orderID.String(), &total, err); mailErr != nil {
+			log.Printf("failed to send payment failure email to %q: %+v", redact.Email(req.Email), mailErr)
+		}
+		return nil, status.Errorf(code, "failed to charge card: %+v", err)
+	}
+	log.Printf("payment authorized (transaction_id: %s)", txID)
+
+	if err := cs.capturePayment(ctx, txID); err != nil {
+		if voidErr := cs.voidPayment(ctx, txID); voidErr != nil {
+			log.Printf("failed to void transaction %s: %+v", txID, voidErr)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to capture payment: %+v", err)
 	}
 	log.Printf("payment went through (transaction_id: %s)", txID)
 
-	shippingTrackingID, err := cs.shipOrder(ctx, req.Address, prep.cartItems)
+	shipment, err := cs.shipOrder(ctx, address, prep.cartItems, serviceLevel)
 	if err != nil {
+		if refundErr := cs.refundPayment(ctx, txID); refundErr != nil {
+			log.Printf("failed to refund transaction %s: %+v", txID, refundErr)
+		}
 		return nil, status.Errorf(codes.Unavailable, "shipping error: %+v", err)
 	}
 
 	_ = cs.emptyUserCart(ctx, req.UserId)
 
 	orderResult := &pb.OrderResult{
-		OrderId:            orderID.String(),
-		ShippingTrackingId: shippingTrackingID,
-		ShippingCost:       prep.shippingCostLocalized,
-		ShippingAddress:    req.Address,
-		Items:              prep.orderItems,
+		OrderId:               orderID.String(),
+		ShippingTrackingId:    shipment.GetTrackingId(),
+		ShippingCost:          prep.shippingCostLocalized,
+		ShippingAddress:       address,
+		Items:                 prep.orderItems,
+		ShippingServiceLevel:  serviceLevel,
+		EstimatedDeliveryDate: prep.estimatedDeliveryDate,
+		Packages:              shipment.GetPackages(),
 	}
 
 	if err := cs.sendOrderConfirmation(ctx, req.Email, orderResult); err != nil {
-		log.Printf("failed to send order confirmation to %q: %+v", req.Email, err)
+		log.Printf("failed to send order confirmation to %q: %+v", redact.Email(req.Email), err)
 	} else {
-		log.Printf("order confirmation email sent to %q", req.Email)
+		log.Printf("order confirmation email sent to %q", redact.Email(req.Email))
+	}
+	if err := cs.recordPurchase(ctx, req.UserId, prep.cartItems); err != nil {
+		log.Printf("failed to record purchase for recommendations: %+v", err)
 	}
 	resp := &pb.PlaceOrderResponse{Order: orderResult}
 	return resp, nil
@@ -155,9 +211,10 @@ type orderPrep struct {
 	orderItems            []*pb.OrderItem
 	cartItems             []*pb.CartItem
 	shippingCostLocalized *pb.Money
+	estimatedDeliveryDate string
 }
 
-func (cs *CheckoutService) prepareOrderItemsAndShippingQuoteFromCart(ctx context.Context, userID, userCurrency string, address *pb.Address) (orderPrep, error) {
+func (cs *CheckoutService) prepareOrderItemsAndShippingQuoteFromCart(ctx context.Context, userID, userCurrency string, address *pb.Address, serviceLevel string) (orderPrep, error) {
 	log.Printf("prepareOrderItemsAndShippingQuoteFromCart: Start processing for userID=%s, userCurrency=%s", userID, userCurrency)
 
 	var out orderPrep
@@ -179,7 +236,7 @@ func (cs *CheckoutService) prepareOrderItemsAndShippingQuoteFromCart(ctx context
 	log.Printf("prepareOrderItemsAndShippingQuoteFromCart: Prepared %d order items for userID=%s", len(orderItems), userID)
 
 	// Quote shipping
-	shippingUSD, err := cs.quoteShipping(ctx, address, cartItems)
+	shippingOption, err := cs.quoteShipping(ctx, address, cartItems, serviceLevel)
 	if err != nil {
 		log.Printf("prepareOrderItemsAndShippingQuoteFromCart: Error quoting shipping for userID=%s: %v", userID, err)
 		return out, fmt.Errorf("shipping quote failure: %+v", err)
@@ -187,7 +244,7 @@ func (cs *CheckoutService) prepareOrderItemsAndShippingQuoteFromCart(ctx context
 	log.Printf("prepareOrderItemsAndShippingQuoteFromCart: Received shipping quote in USD for userID=%s", userID)
 
 	// Convert shipping cost
-	shippingPrice, err := cs.convertCurrency(shippingUSD, userCurrency)
+	shippingPrice, err := cs.convertCurrency(shippingOption.GetCostUsd(), userCurrency)
 	if err != nil {
 		log.Printf("prepareOrderItemsAndShippingQuoteFromCart: Error converting shipping cost to currency=%s for userID=%s: %v", userCurrency, userID, err)
 		return out, fmt.Errorf("failed to convert shipping cost to currency: %+v", err)
@@ -195,20 +252,42 @@ func (cs *CheckoutService) prepareOrderItemsAndShippingQuoteFromCart(ctx context
 	log.Printf("prepareOrderItemsAndShippingQuoteFromCart: Converted shipping cost to currency=%s for userID=%s", userCurrency, userID)
 
 	out.shippingCostLocalized = shippingPrice
+	out.estimatedDeliveryDate = shippingOption.GetEstimatedDeliveryDate()
 	out.cartItems = cartItems
 	out.orderItems = orderItems
 	return out, nil
 }
 
-func (cs *CheckoutService) quoteShipping(ctx context.Context, address *pb.Address, items []*pb.CartItem) (*pb.Money, error) {
+// validateAddress returns the normalized address, or an InvalidArgument
+// error listing what is wrong with it.
+func (cs *CheckoutService) validateAddress(ctx context.Context, address *pb.Address) (*pb.Address, error) {
+	resp, err := pb.NewShippingServiceClient(cs.shippingSvcConn).
+		ValidateAddress(ctx, &pb.ValidateAddressRequest{Address: address})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to validate address: %+v", err)
+	}
+	if !resp.GetDeliverable() {
+		return nil, status.Errorf(codes.InvalidArgument, "undeliverable address: %s", addressIssues(resp.GetIssues()))
+	}
+	return resp.GetNormalized(), nil
+}
+
+// quoteShipping returns the shipping option for the chosen service level.
+func (cs *CheckoutService) quoteShipping(ctx context.Context, address *pb.Address, items []*pb.CartItem, serviceLevel string) (*pb.ShippingOption, error) {
 	shippingQuote, err := pb.NewShippingServiceClient(cs.shippingSvcConn).
 		GetQuote(ctx, &pb.GetQuoteRequest{
-			Address: address,
-			Items:   items})
+			Address:      address,
+			Items:        items,
+			ServiceLevel: serviceLevel})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get shipping quote: %+v", err)
 	}
-	return shippingQuote.GetCostUsd(), nil
+	for _, o := range shippingQuote.GetOptions() {
+		if o.GetServiceLevel() == serviceLevel {
+			return o, nil
+		}
+	}
+	return &pb.ShippingOption{ServiceLevel: serviceLevel, CostUsd: shippingQuote.GetCostUsd()}, nil
 }
 
 func (cs *CheckoutService) getUserCart(ctx context.Context, userID string) ([]*pb.CartItem, error) {
@@ -235,13 +314,26 @@ func (cs *CheckoutService) prepOrderItems(ctx context.Context, items []*pb.CartI
 		if err != nil {
 			return nil, fmt.Errorf("failed to get product #%q", item.GetProductId())
 		}
-		price, err := cs.convertCurrency(product.GetPriceUsd(), userCurrency)
+		if product.GetOutOfStock() {
+			return nil, fmt.Errorf("product #%q is out of stock", item.GetProductId())
+		}
+		variant, err := findVariant(product, item.GetSku())
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert price of %q to %s", item.GetProductId(), userCurrency)
+			return nil, err
+		}
+		price, ok := listPrice(product, variant, userCurrency)
+		source := pb.PricingSource_PRICING_SOURCE_LIST_PRICE
+		if !ok {
+			price, err = cs.convertCurrency(variantPrice(product, variant), userCurrency)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert price of %q to %s", item.GetProductId(), userCurrency)
+			}
+			source = pb.PricingSource_PRICING_SOURCE_CONVERTED
 		}
 		out[i] = &pb.OrderItem{
-			Item: item,
-			Cost: price}
+			Item:          item,
+			Cost:          price,
+			PricingSource: source}
 	}
 	return out, nil
 }
@@ -256,31 +348,109 @@ func (cs *CheckoutService) convertCurrency(from *pb.Money, toCurrency string) (*
 	return result, err
 }
 
-func (cs *CheckoutService) chargeCard(ctx context.Context, amount *pb.Money, paymentInfo *pb.CreditCardInfo) (string, error) {
-	paymentResp, err := pb.NewPaymentServiceClient(cs.paymentSvcConn).Charge(ctx, &pb.ChargeRequest{
+func (cs *CheckoutService) authorizeCard(ctx context.Context, amount *pb.Money, paymentInfo *pb.CreditCardInfo) (string, error) {
+	paymentResp, err := pb.NewPaymentServiceClient(cs.paymentSvcConn).Authorize(ctx, &pb.AuthorizeRequest{
 		Amount:     amount,
 		CreditCard: paymentInfo})
 	if err != nil {
-		return "", fmt.Errorf("could not charge the card: %+v", err)
+		return "", fmt.Errorf("could not charge the card: %w", err)
 	}
 	return paymentResp.GetTransactionId(), nil
 }
 
+func (cs *CheckoutService) capturePayment(ctx context.Context, transactionID string) error {
+	if _, err := pb.NewPaymentServiceClient(cs.paymentSvcConn).Capture(ctx, &pb.CaptureRequest{TransactionId: transactionID}); err != nil {
+		return fmt.Errorf("could not capture transaction %s: %w", transactionID, err)
+	}
+	return nil
+}
+
+func (cs *CheckoutService) voidPayment(ctx context.Context, transactionID string) error {
+	if _, err := pb.NewPaymentServiceClient(cs.paymentSvcConn).Void(ctx, &pb.VoidRequest{TransactionId: transactionID}); err != nil {
+		return fmt.Errorf("could not void transaction %s: %w", transactionID, err)
+	}
+	return nil
+}
+
+// refundPayment refunds everything captured on a transaction.
+func (cs *CheckoutService) refundPayment(ctx context.Context, transactionID string) error {
+	if _, err := pb.NewPaymentServiceClient(cs.paymentSvcConn).Refund(ctx, &pb.RefundRequest{TransactionId: transactionID}); err != nil {
+		return fmt.Errorf("could not refund transaction %s: %w", transactionID, err)
+	}
+	return nil
+}
+
 func (cs *CheckoutService) sendOrderConfirmation(ctx context.Context, email string, order *pb.OrderResult) error {
 	_, err := pb.NewEmailServiceClient(cs.emailSvcConn).SendOrderConfirmation(ctx, &pb.SendOrderConfirmationRequest{
-		Email: email,
-		Order: order})
+		Email:          email,
+		Order:          order,
+		IdempotencyKey: "order_confirmation/" + order.GetOrderId()})
+	return err
+}
+
+func (cs *CheckoutService) sendPaymentFailed(ctx context.Context, email, orderID string, amount *pb.Money, paymentErr error) error {
+	_, err := pb.NewEmailServiceClient(cs.emailSvcConn).SendPaymentFailed(ctx, &pb.SendPaymentFailedRequest{
+		Email:          email,
+		IdempotencyKey: "payment_failed/" + orderID,
+		OrderId:        orderID,
+		Amount:         amount,
+		Reason:         paymentFailureReason(paymentErr)})
 	return err
 }
 
-func (cs *CheckoutService) shipOrder(ctx context.Context, address *pb.Address, items []*pb.CartItem) (string, error) {
+// paymentFailureReason explains a PaymentService error to a customer. It goes
+// by the ErrorInfo reason rather than the status code, which several
+// reasons share.
+func paymentFailureReason(err error) string {
+	switch paymentErrorReason(err) {
+	case "CARD_INVALID":
+		return "The card details you entered are not valid."
+	case "CARD_NETWORK_NOT_ACCEPTED":
+		return "We do not accept this type of card. Please use Visa, MasterCard, American Express or Discover."
+	case "CARD_EXPIRED":
+		return "Your card has expired."
+	case "CARD_DECLINED":
+		return "Your card was declined."
+	case "INSUFFICIENT_FUNDS":
+		return "Your card has insufficient funds."
+	case "FRAUD_HOLD":
+		return "Your payment is being held for review by your bank."
+	default:
+		return "We could not reach our payment provider. Please try again shortly."
+	}
+}
+
+// paymentErrorReason returns the reason of the payment ErrorInfo attached to
+// err, or "" if there is none.
+func paymentErrorReason(err error) string {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetDomain() == paymentErrorDomain {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+func (cs *CheckoutService) recordPurchase(ctx context.Context, userID string, items []*pb.CartItem) error {
+	ids := make([]string, len(items))
+	for i, it := range items {
+		ids[i] = it.GetProductId()
+	}
+	_, err := pb.NewRecommendationServiceClient(cs.recommendationSvcConn).RecordPurchase(ctx, &pb.RecordPurchaseRequest{
+		UserId:     userID,
+		ProductIds: ids})
+	return err
+}
+
+func (cs *CheckoutService) shipOrder(ctx context.Context, address *pb.Address, items []*pb.CartItem, serviceLevel string) (*pb.ShipOrderResponse, error) {
 	resp, err := pb.NewShippingServiceClient(cs.shippingSvcConn).ShipOrder(ctx, &pb.ShipOrderRequest{
-		Address: address,
-		Items:   items})
+		Address:      address,
+		Items:        items,
+		ServiceLevel: serviceLevel})
 	if err != nil {
-		return "", fmt.Errorf("shipment failed: %+v", err)
+		return nil, fmt.Errorf("shipment failed: %+v", err)
 	}
-	return resp.GetTrackingId(), nil
+	return resp, nil
 }
 
 // IsValid checks if specified value has a valid units/nanos signs and ranges.
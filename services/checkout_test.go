@@ -0,0 +1,288 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// fakePayment records the payment operations it is asked for and fails
+// those named in fail.
+type fakePayment struct {
+	pb.UnimplementedPaymentServiceServer
+	fail map[string]bool
+
+	mu  sync.Mutex
+	ops []string
+}
+
+func (p *fakePayment) do(op string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.ops = append(p.ops, op)
+	if p.fail[op] {
+		return status.Errorf(codes.Unavailable, "%s failed", op)
+	}
+	return nil
+}
+
+func (p *fakePayment) Authorize(context.Context, *pb.AuthorizeRequest) (*pb.AuthorizeResponse, error) {
+	if err := p.do("authorize"); err != nil {
+		return nil, err
+	}
+	return &pb.AuthorizeResponse{TransactionId: "tx"}, nil
+}
+
+func (p *fakePayment) Capture(context.Context, *pb.CaptureRequest) (*pb.Transaction, error) {
+	if err := p.do("capture"); err != nil {
+		return nil, err
+	}
+	return &pb.Transaction{}, nil
+}
+
+func (p *fakePayment) Void(context.Context, *pb.VoidRequest) (*pb.Transaction, error) {
+	if err := p.do("void"); err != nil {
+		return nil, err
+	}
+	return &pb.Transaction{}, nil
+}
+
+func (p *fakePayment) Refund(context.Context, *pb.RefundRequest) (*pb.Transaction, error) {
+	if err := p.do("refund"); err != nil {
+		return nil, err
+	}
+	return &pb.Transaction{}, nil
+}
+
+// checkoutShipping accepts every address and ships unless told to fail.
+type checkoutShipping struct {
+	fakeShipping
+	fail bool
+
+	mu      sync.Mutex
+	shipped int
+}
+
+func (s *checkoutShipping) ValidateAddress(_ context.Context, req *pb.ValidateAddressRequest) (*pb.ValidateAddressResponse, error) {
+	return &pb.ValidateAddressResponse{Deliverable: true, Normalized: req.GetAddress()}, nil
+}
+
+func (s *checkoutShipping) ShipOrder(context.Context, *pb.ShipOrderRequest) (*pb.ShipOrderResponse, error) {
+	if s.fail {
+		return nil, status.Error(codes.Unavailable, "carrier down")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.shipped++
+	return &pb.ShipOrderResponse{TrackingId: "TRACK"}, nil
+}
+
+// TestPlaceOrderPaymentLifecycle checks that an order is only shipped once
+// its payment is captured, and that every failure after authorization
+// releases or returns the money.
+func TestPlaceOrderPaymentLifecycle(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		failPayment string
+		failShip    bool
+		wantOps     []string
+		wantShipped int
+		wantCart    int
+	}{
+		{name: "success", wantOps: []string{"authorize", "capture"}, wantShipped: 1},
+		{name: "capture fails", failPayment: "capture", wantOps: []string{"authorize", "capture", "void"}, wantCart: 1},
+		{name: "shipping fails", failShip: true, wantOps: []string{"authorize", "capture", "refund"}, wantCart: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			catalog := &fakeCatalog{products: []*pb.Product{{Id: "SUNGLASSES", Name: "Sunglasses", PriceUsd: usd(19, 990000000)}}}
+			cart := newFakeCart()
+			cart.carts["user"] = []*pb.CartItem{{ProductId: "SUNGLASSES", Quantity: 1}}
+			payment := &fakePayment{fail: map[string]bool{tc.failPayment: true}}
+			shipping := &checkoutShipping{fail: tc.failShip}
+			none := dialServer(t, func(*grpc.Server) {})
+			cs := &CheckoutService{
+				productCatalogSvcConn: dialServer(t, func(s *grpc.Server) { pb.RegisterProductCatalogServiceServer(s, catalog) }),
+				cartSvcConn:           dialServer(t, func(s *grpc.Server) { pb.RegisterCartServiceServer(s, cart) }),
+				currencySvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterCurrencyServiceServer(s, fakeCurrency{}) }),
+				shippingSvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterShippingServiceServer(s, shipping) }),
+				paymentSvcConn:        dialServer(t, func(s *grpc.Server) { pb.RegisterPaymentServiceServer(s, payment) }),
+				emailSvcConn:          none,
+				recommendationSvcConn: none,
+			}
+
+			_, err := cs.PlaceOrder(context.Background(), &pb.PlaceOrderRequest{
+				UserId:       "user",
+				UserCurrency: "USD",
+				Email:        "someone@example.com",
+				Address:      quoteAddress,
+				CreditCard:   &pb.CreditCardInfo{CreditCardNumber: "4432801561520454"},
+			})
+			if wantErr := tc.failPayment != "" || tc.failShip; (err != nil) != wantErr {
+				t.Errorf("PlaceOrder: err = %v, want error: %v", err, wantErr)
+			}
+			if !reflect.DeepEqual(payment.ops, tc.wantOps) {
+				t.Errorf("payment operations = %v, want %v", payment.ops, tc.wantOps)
+			}
+			if shipping.shipped != tc.wantShipped {
+				t.Errorf("shipped %d times, want %d", shipping.shipped, tc.wantShipped)
+			}
+			if got := len(cart.items("user")); got != tc.wantCart {
+				t.Errorf("cart has %d items, want %d", got, tc.wantCart)
+			}
+		})
+	}
+}
+
+func TestPlaceOrderEmptyCart(t *testing.T) {
+	payment := &fakePayment{}
+	shipping := &checkoutShipping{}
+	none := dialServer(t, func(*grpc.Server) {})
+	cs := &CheckoutService{
+		productCatalogSvcConn: dialServer(t, func(s *grpc.Server) { pb.RegisterProductCatalogServiceServer(s, &fakeCatalog{}) }),
+		cartSvcConn:           dialServer(t, func(s *grpc.Server) { pb.RegisterCartServiceServer(s, newFakeCart()) }),
+		currencySvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterCurrencyServiceServer(s, fakeCurrency{}) }),
+		shippingSvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterShippingServiceServer(s, shipping) }),
+		paymentSvcConn:        dialServer(t, func(s *grpc.Server) { pb.RegisterPaymentServiceServer(s, payment) }),
+		emailSvcConn:          none,
+		recommendationSvcConn: none,
+	}
+
+	_, err := cs.PlaceOrder(context.Background(), &pb.PlaceOrderRequest{
+		UserId:       "user",
+		UserCurrency: "USD",
+		Email:        "someone@example.com",
+		Address:      quoteAddress,
+		CreditCard:   &pb.CreditCardInfo{CreditCardNumber: "4432801561520454"},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PlaceOrder with an empty cart: err = %v, want InvalidArgument", err)
+	}
+	if len(payment.ops) != 0 || shipping.shipped != 0 {
+		t.Errorf("empty cart led to payment operations %v and %d shipments", payment.ops, shipping.shipped)
+	}
+}
+
+// TestPrepOrderItemsPricing checks that a variant's own price and the
+// catalog's local prices win over converting the USD price.
+func TestPrepOrderItemsPricing(t *testing.T) {
+	eur := func(units int64) *pb.Money { return &pb.Money{CurrencyCode: "EUR", Units: units} }
+	shirt := &pb.Product{
+		Id:          "SHIRT",
+		PriceUsd:    usd(20, 0),
+		LocalPrices: []*pb.Money{eur(18)},
+		Variants: []*pb.ProductVariant{
+			{Sku: "SHIRT-S"},
+			{Sku: "SHIRT-L", PriceUsd: usd(25, 0), LocalPrices: []*pb.Money{eur(23)}},
+			{Sku: "SHIRT-XL", PriceUsd: usd(30, 0)},
+		},
+	}
+	cs := &CheckoutService{
+		productCatalogSvcConn: dialServer(t, func(s *grpc.Server) {
+			pb.RegisterProductCatalogServiceServer(s, &fakeCatalog{products: []*pb.Product{shirt}})
+		}),
+		currencySvcConn: dialServer(t, func(s *grpc.Server) { pb.RegisterCurrencyServiceServer(s, fakeCurrency{}) }),
+	}
+
+	for _, tc := range []struct {
+		sku, currency string
+		want          *pb.Money
+		wantSource    pb.PricingSource
+	}{
+		{"SHIRT-S", "USD", usd(20, 0), pb.PricingSource_PRICING_SOURCE_LIST_PRICE},
+		{"SHIRT-S", "EUR", eur(18), pb.PricingSource_PRICING_SOURCE_LIST_PRICE},
+		{"SHIRT-L", "USD", usd(25, 0), pb.PricingSource_PRICING_SOURCE_LIST_PRICE},
+		{"SHIRT-L", "EUR", eur(23), pb.PricingSource_PRICING_SOURCE_LIST_PRICE},
+		// The product's local price is for its own USD price, not the
+		// variant's, so it must not be used.
+		{"SHIRT-XL", "EUR", eur(30), pb.PricingSource_PRICING_SOURCE_CONVERTED},
+	} {
+		items, err := cs.prepOrderItems(context.Background(), []*pb.CartItem{{ProductId: "SHIRT", Sku: tc.sku, Quantity: 1}}, tc.currency)
+		if err != nil {
+			t.Errorf("prepOrderItems(%s, %s): %v", tc.sku, tc.currency, err)
+			continue
+		}
+		got := items[0]
+		if got.GetCost().GetCurrencyCode() != tc.want.GetCurrencyCode() || got.GetCost().GetUnits() != tc.want.GetUnits() || got.GetCost().GetNanos() != tc.want.GetNanos() {
+			t.Errorf("%s in %s costs %v, want %v", tc.sku, tc.currency, got.GetCost(), tc.want)
+		}
+		if got.GetPricingSource() != tc.wantSource {
+			t.Errorf("%s in %s priced from %v, want %v", tc.sku, tc.currency, got.GetPricingSource(), tc.wantSource)
+		}
+	}
+}
+
+// failedPaymentEmail records the reasons of payment failure emails.
+type failedPaymentEmail struct {
+	pb.UnimplementedEmailServiceServer
+
+	mu      sync.Mutex
+	reasons []string
+}
+
+func (e *failedPaymentEmail) SendPaymentFailed(_ context.Context, req *pb.SendPaymentFailedRequest) (*pb.Empty, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.reasons = append(e.reasons, req.GetReason())
+	return &pb.Empty{}, nil
+}
+
+func TestPaymentFailedEmailReason(t *testing.T) {
+	l, err := newLedger(memoryLedgerStore{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payment := &PaymentService{processor: newFakeGateway(fakeGatewayConfig{Seed: 1}), ledger: l}
+
+	for _, tc := range []struct {
+		name, number string
+		want         string
+	}{
+		// Both are FailedPrecondition; only the ErrorInfo tells them apart.
+		{"declined", cardDecline, "Your card was declined."},
+		{"network not accepted", "3530111333300000", "We do not accept this type of card. Please use Visa, MasterCard, American Express or Discover."},
+		{"insufficient funds", cardInsufficientFunds, "Your card has insufficient funds."},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			catalog := &fakeCatalog{products: []*pb.Product{{Id: "SUNGLASSES", Name: "Sunglasses", PriceUsd: usd(19, 990000000)}}}
+			cart := newFakeCart()
+			cart.carts["user"] = []*pb.CartItem{{ProductId: "SUNGLASSES", Quantity: 1}}
+			email := &failedPaymentEmail{}
+			none := dialServer(t, func(*grpc.Server) {})
+			cs := &CheckoutService{
+				productCatalogSvcConn: dialServer(t, func(s *grpc.Server) { pb.RegisterProductCatalogServiceServer(s, catalog) }),
+				cartSvcConn:           dialServer(t, func(s *grpc.Server) { pb.RegisterCartServiceServer(s, cart) }),
+				currencySvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterCurrencyServiceServer(s, fakeCurrency{}) }),
+				shippingSvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterShippingServiceServer(s, &checkoutShipping{}) }),
+				paymentSvcConn:        dialServer(t, func(s *grpc.Server) { pb.RegisterPaymentServiceServer(s, payment) }),
+				emailSvcConn:          dialServer(t, func(s *grpc.Server) { pb.RegisterEmailServiceServer(s, email) }),
+				recommendationSvcConn: none,
+			}
+
+			_, err := cs.PlaceOrder(context.Background(), &pb.PlaceOrderRequest{
+				UserId:       "user",
+				UserCurrency: "USD",
+				Email:        "someone@example.com",
+				Address:      quoteAddress,
+				CreditCard: &pb.CreditCardInfo{
+					CreditCardNumber:          tc.number,
+					CreditCardCvvDigits:       "672",
+					CreditCardExpirationMonth: 1,
+					CreditCardExpirationYear:  cardExpirationYear,
+				},
+			})
+			if err == nil {
+				t.Fatal("PlaceOrder succeeded, want a payment failure")
+			}
+			if len(email.reasons) != 1 || email.reasons[0] != tc.want {
+				t.Errorf("payment failure emails = %q, want one with %q", email.reasons, tc.want)
+			}
+		})
+	}
+}
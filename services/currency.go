@@ -5,9 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"math"
+	"math/big"
 	"net"
-	"strconv"
+	"sort"
+	"strings"
 
 	"google.golang.org/grpc"
 
@@ -19,14 +20,18 @@ import (
 )
 
 const (
-	filePath = "data/currency_conversion.json"
+	filePath      = "data/currency_conversion.json"
+	pairsFilePath = "data/currency_pairs.json"
+
+	// baseCurrency is the currency every rate in filePath is quoted against.
+	baseCurrency = "EUR"
 )
 
 // CurrencyService implements the CurrencyService
 type CurrencyService struct {
 	port int
 	pb.CurrencyServiceServer
-	conversionMap map[string]float64
+	rates *rateTable
 }
 
 // NewCurrencyService returns a new server for the CurrencyService
@@ -37,13 +42,20 @@ func NewCurrencyService(port int) *CurrencyService {
 		log.Fatalf("Failed to read file: %v", err)
 	}
 
-	conversionMap, err := createConversionMap(currencyData)
+	// Direct pair quotes are optional; without them every conversion goes
+	// through the base currency.
+	pairsData, err := os.ReadFile(pairsFilePath)
+	if err != nil && !os.IsNotExist(err) {
+		log.Fatalf("Failed to read file: %v", err)
+	}
+
+	rates, err := createRateTable(baseCurrency, currencyData, pairsData)
 	if err != nil {
-		return nil
+		log.Fatalf("Failed to parse currency data: %v", err)
 	}
 	return &CurrencyService{
-		port:          port,
-		conversionMap: conversionMap,
+		port:  port,
+		rates: rates,
 	}
 }
 
@@ -67,12 +79,8 @@ func (s *CurrencyService) Run() error {
 // GetSupportedCurrencies returns a list of supported currency codes
 func (s *CurrencyService) GetSupportedCurrencies(ctx context.Context, req *pb.EmptyUser) (*pb.GetSupportedCurrenciesResponse, error) {
 	log.Printf("GetSupportedCurrencies request received")
-	keys := make([]string, 0, len(s.conversionMap))
-	for k := range s.conversionMap {
-		keys = append(keys, k)
-	}
 	return &pb.GetSupportedCurrenciesResponse{
-		CurrencyCodes: keys,
+		CurrencyCodes: s.rates.currencies(),
 	}, nil
 }
 
@@ -83,53 +91,177 @@ func (s *CurrencyService) Convert(ctx context.Context, req *pb.CurrencyConversio
 	from := req.GetFrom()
 	toCode := req.GetToCode()
 
-	// Convert: from -> EUR
-	fromRate, ok := s.conversionMap[from.GetCurrencyCode()]
-	if !ok {
-		return nil, fmt.Errorf("unsupported currency code: %v", from.GetCurrencyCode())
+	rate, err := s.rates.rate(from.GetCurrencyCode(), toCode)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := moneyToRat(from)
+	amount.Mul(amount, rate)
+
+	to := ratToMoney(amount)
+	to.CurrencyCode = toCode
+	return to, nil
+}
+
+// rateTable holds exchange rates as exact decimals. A conversion uses, in
+// order: the identity rate for same-currency conversions, an explicit pair
+// quote, the inverse of an explicit pair quote, and finally a cross rate
+// through the base currency.
+type rateTable struct {
+	base string
+
+	// baseRates maps a currency code to the number of units of that
+	// currency per one unit of base.
+	baseRates map[string]*big.Rat
+
+	// pairs maps "FROM/TO" to the number of units of TO per one unit of
+	// FROM.
+	pairs map[string]*big.Rat
+}
+
+// rate returns the multiplier that converts an amount in from into to.
+func (t *rateTable) rate(from, to string) (*big.Rat, error) {
+	if !t.supports(from) {
+		return nil, fmt.Errorf("unsupported currency code: %v", from)
+	}
+	if !t.supports(to) {
+		return nil, fmt.Errorf("unsupported currency code: %v", to)
+	}
+
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+	if r, ok := t.pairs[pairKey(from, to)]; ok {
+		return new(big.Rat).Set(r), nil
+	}
+	if r, ok := t.pairs[pairKey(to, from)]; ok {
+		return new(big.Rat).Inv(r), nil
 	}
-	euros := carry(float64(from.GetUnits())/fromRate, float64(from.GetNanos())/fromRate)
 
-	// Convert: EUR -> toCode
-	toRate, ok := s.conversionMap[toCode]
+	fromRate, ok := t.baseRates[from]
 	if !ok {
-		return nil, fmt.Errorf("unsupported currency code: %v", toCode)
+		return nil, fmt.Errorf("no rate from %v to %v", from, to)
 	}
-	to := carry(float64(euros.Units)*toRate, float64(euros.Nanos)*toRate)
-	to.CurrencyCode = toCode
+	toRate, ok := t.baseRates[to]
+	if !ok {
+		return nil, fmt.Errorf("no rate from %v to %v", from, to)
+	}
+	return new(big.Rat).Quo(toRate, fromRate), nil
+}
 
-	return &pb.Money{
-		CurrencyCode: to.CurrencyCode,
-		Units:        to.Units,
-		Nanos:        to.Nanos,
-	}, nil
+// supports reports whether code appears anywhere in the table.
+func (t *rateTable) supports(code string) bool {
+	if _, ok := t.baseRates[code]; ok {
+		return true
+	}
+	for k := range t.pairs {
+		f, to, _ := strings.Cut(k, "/")
+		if f == code || to == code {
+			return true
+		}
+	}
+	return false
 }
 
-// carry handles decimal/fractional carrying for currency conversions.
-func carry(units float64, nanos float64) pb.Money {
-	const fractionSize = 1000000000 // 1B
-	nanos += math.Mod(units, 1.0) * fractionSize
-	units = math.Floor(units) + math.Floor(nanos/fractionSize)
-	nanos = math.Mod(nanos, fractionSize)
-	return pb.Money{
-		Units: int64(units),
-		Nanos: int32(nanos),
+// currencies returns every currency code in the table, sorted.
+func (t *rateTable) currencies() []string {
+	seen := make(map[string]struct{}, len(t.baseRates))
+	for k := range t.baseRates {
+		seen[k] = struct{}{}
+	}
+	for k := range t.pairs {
+		f, to, _ := strings.Cut(k, "/")
+		seen[f] = struct{}{}
+		seen[to] = struct{}{}
+	}
+	keys := make([]string, 0, len(seen))
+	for k := range seen {
+		keys = append(keys, k)
 	}
+	sort.Strings(keys)
+	return keys
 }
 
-// createConversionMap parses the currency conversion JSON data.
-func createConversionMap(currencyData []byte) (map[string]float64, error) {
+func pairKey(from, to string) string {
+	return from + "/" + to
+}
+
+// createRateTable parses the base-currency rates and the optional direct
+// pair quotes. Both are JSON objects with decimal strings as values, keyed by
+// currency code and by "FROM/TO" respectively.
+func createRateTable(base string, currencyData, pairsData []byte) (*rateTable, error) {
+	baseRates, err := parseRates(currencyData)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := baseRates[base]; !ok {
+		baseRates[base] = big.NewRat(1, 1)
+	}
+
+	pairs := map[string]*big.Rat{}
+	if len(pairsData) > 0 {
+		if pairs, err = parseRates(pairsData); err != nil {
+			return nil, err
+		}
+		for k := range pairs {
+			if f, to, ok := strings.Cut(k, "/"); !ok || f == "" || to == "" {
+				return nil, fmt.Errorf("invalid currency pair %q", k)
+			}
+		}
+	}
+
+	return &rateTable{
+		base:      base,
+		baseRates: baseRates,
+		pairs:     pairs,
+	}, nil
+}
+
+// parseRates parses a JSON object of decimal strings into exact rationals.
+func parseRates(data []byte) (map[string]*big.Rat, error) {
 	m := map[string]string{}
-	if err := json.Unmarshal(currencyData, &m); err != nil {
+	if err := json.Unmarshal(data, &m); err != nil {
 		return nil, err
 	}
-	conv := make(map[string]float64, len(m))
+	rates := make(map[string]*big.Rat, len(m))
 	for k, v := range m {
-		f, err := strconv.ParseFloat(v, 64)
-		if err != nil {
-			return nil, err
+		r, ok := new(big.Rat).SetString(v)
+		if !ok || r.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid rate %q for %s", v, k)
 		}
-		conv[k] = f
+		rates[k] = r
+	}
+	return rates, nil
+}
+
+var nanosPerUnit = big.NewInt(nanosMod)
+
+// moneyToRat returns the exact value of m in units.
+func moneyToRat(m *pb.Money) *big.Rat {
+	n := new(big.Int).Mul(big.NewInt(m.GetUnits()), nanosPerUnit)
+	n.Add(n, big.NewInt(int64(m.GetNanos())))
+	return new(big.Rat).SetFrac(n, nanosPerUnit)
+}
+
+// ratToMoney rounds r to the nearest nano, with halves rounded away from
+// zero, and splits it into units and nanos of the same sign.
+func ratToMoney(r *big.Rat) *pb.Money {
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(nanosPerUnit))
+
+	num := new(big.Int).Abs(scaled.Num())
+	den := scaled.Denom()
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Lsh(rem, 1).Cmp(den) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if scaled.Sign() < 0 {
+		q.Neg(q)
+	}
+
+	units, nanos := new(big.Int).QuoRem(q, nanosPerUnit, new(big.Int))
+	return &pb.Money{
+		Units: units.Int64(),
+		Nanos: int32(nanos.Int64()),
 	}
-	return conv, nil
 }
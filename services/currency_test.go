@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log"
+	"math/big"
+	"os"
+	"testing"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// minorUnit returns the smallest amount of currency that can be paid.
+func minorUnit(currency string) *big.Rat {
+	if zeroDecimalCurrencies[currency] {
+		return big.NewRat(1, 1)
+	}
+	return big.NewRat(1, 100)
+}
+
+// roundToMinorUnit rounds m to the nearest amount that can be paid, as
+// prices are shown and charged.
+func roundToMinorUnit(m *pb.Money) *pb.Money {
+	unit := minorUnit(m.GetCurrencyCode())
+	units := new(big.Rat).Quo(moneyToRat(m), unit)
+	units.Add(units, big.NewRat(1, 2))
+	whole := new(big.Int).Quo(units.Num(), units.Denom()) // amounts are positive
+	out := ratToMoney(new(big.Rat).Mul(new(big.Rat).SetInt(whole), unit))
+	out.CurrencyCode = m.GetCurrencyCode()
+	return out
+}
+
+// TestConvertRoundTrip converts amounts between every two supported
+// currencies and back, rounding to the minor unit in between as prices are
+// shown and charged. That covers quoted pairs, their inverses and cross
+// rates through the base currency. Rounding in the middle can lose half a
+// minor unit of the intermediate currency, so the round trip may be off by
+// at most one minor unit of it.
+func TestConvertRoundTrip(t *testing.T) {
+	currencyData, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairsData, err := os.ReadFile(pairsFilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rates, err := createRateTable(baseCurrency, currencyData, pairsData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &CurrencyService{rates: rates}
+
+	currencies := rates.currencies()
+	if len(currencies) < 2 {
+		t.Fatalf("rate table has only %v", currencies)
+	}
+	stderr := log.Writer()
+	defer log.SetOutput(stderr)
+	log.SetOutput(io.Discard) // Convert logs every request
+
+	for _, a := range currencies {
+		for _, b := range currencies {
+			if a == b {
+				continue
+			}
+			rate, err := rates.rate(a, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, amount := range []string{"0.01", "1", "19.99", "1234.56", "99999.99"} {
+				start := ratToMoney(mustRat(amount))
+				start.CurrencyCode = a
+				start = roundToMinorUnit(start)
+
+				there, err := s.Convert(context.Background(), &pb.CurrencyConversionRequest{From: start, ToCode: b})
+				if err != nil {
+					t.Fatalf("Convert(%v -> %s): %v", start, b, err)
+				}
+				back, err := s.Convert(context.Background(), &pb.CurrencyConversionRequest{From: roundToMinorUnit(there), ToCode: a})
+				if err != nil {
+					t.Fatalf("Convert(%v -> %s): %v", there, a, err)
+				}
+				// Measure the difference in b, the currency rounded to.
+				diff := new(big.Rat).Sub(moneyToRat(back), moneyToRat(start))
+				diff.Mul(diff.Abs(diff), rate)
+				if diff.Cmp(minorUnit(b)) > 0 {
+					t.Errorf("%s %s -> %s -> %s: got back %s, off by %s %s",
+						a, moneyToRat(start).FloatString(2), b, a, moneyToRat(back).FloatString(4), diff.FloatString(4), b)
+				}
+			}
+		}
+	}
+}
+
+func mustRat(s string) *big.Rat {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		panic(s)
+	}
+	return r
+}
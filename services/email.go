@@ -1,33 +1,55 @@
 package services
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"html/template"
 	"log"
 	"net"
+	"net/mail"
+	"os"
+	"strings"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+	"github.com/deskchen/online-boutique-grpc/services/redact"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/opentracing/opentracing-go"
 )
 
-// Embed the HTML template for the email
-var (
-	tmpl = template.Must(template.New("email").
-		Funcs(template.FuncMap{
-			"div": func(x, y int32) int32 { return x / y },
-		}).
-		Parse("./templates/email.html"))
-)
-
 // NewEmailService returns a new server for the EmailService
 func NewEmailService(port int) *EmailService {
+	tmpls, err := loadEmailTemplates(emailTemplatesDir)
+	if err != nil {
+		log.Fatalf("Failed to load email templates: %v", err)
+	}
+
+	from := os.Getenv("EMAIL_FROM")
+	if from == "" {
+		from = defaultEmailFrom
+	}
+
+	var journal *emailJournal
+	if path := os.Getenv("EMAIL_QUEUE_JOURNAL"); path != "" {
+		log.Printf("Journaling email queue to %s", path)
+		journal = newEmailJournal(path, envInt64("EMAIL_QUEUE_JOURNAL_COMPACT_BYTES", 8<<20))
+	}
+	mailer, err := newMailerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to set up mailer: %v", err)
+	}
+	queue, err := newEmailQueue(mailer, emailQueueConfigFromEnv(), journal)
+	if err != nil {
+		log.Fatalf("Failed to load email queue: %v", err)
+	}
+
 	return &EmailService{
-		port: port,
+		port:      port,
+		from:      from,
+		queue:     queue,
+		templates: tmpls,
 	}
 }
 
@@ -35,16 +57,25 @@ func NewEmailService(port int) *EmailService {
 type EmailService struct {
 	port int
 	pb.EmailServiceServer
+
+	from      string
+	queue     *emailQueue
+	templates map[string]*emailTemplate
 }
 
 // Run starts the server
 func (s *EmailService) Run() error {
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer())),
+		grpc.ChainUnaryInterceptor(
+			otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer()),
+			redact.UnaryServerInterceptor(),
+		),
 	}
 	srv := grpc.NewServer(opts...)
 	pb.RegisterEmailServiceServer(srv, s)
 
+	s.queue.start(context.Background())
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
@@ -55,21 +86,144 @@ func (s *EmailService) Run() error {
 
 // SendOrderConfirmation sends an order confirmation email
 func (s *EmailService) SendOrderConfirmation(ctx context.Context, req *pb.SendOrderConfirmationRequest) (*pb.Empty, error) {
-	log.Printf("SendOrderConfirmation request received for email = %v", req.GetEmail())
+	log.Printf("SendOrderConfirmation request received for email = %v", redact.Email(req.GetEmail()))
 
-	// Generate email content using the template
-	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, req.GetOrder()); err != nil {
-		log.Printf("Error executing template: %v", err)
-		return nil, err
+	key := req.GetIdempotencyKey()
+	if key == "" && req.GetOrder().GetOrderId() != "" {
+		key = emailOrderConfirmation + "/" + req.GetOrder().GetOrderId()
+	}
+	data := orderConfirmationData{Order: req.GetOrder()}
+	if total, err := orderTotal(req.GetOrder()); err == nil {
+		data.Total = total
 	}
-	confirmation := buf.String()
+	return s.send(emailOrderConfirmation, req.GetEmail(), key, data)
+}
 
-	// Simulate sending the email
-	log.Printf("Order confirmation email content for %v:\n%s", req.GetEmail(), confirmation)
+// SendShippingUpdate tells the customer a shipment's status changed
+func (s *EmailService) SendShippingUpdate(ctx context.Context, req *pb.SendShippingUpdateRequest) (*pb.Empty, error) {
+	log.Printf("SendShippingUpdate request received for email = %v", redact.Email(req.GetEmail()))
 
-	// Replace this with actual email-sending logic if needed
-	log.Printf("Order confirmation email sent to %v", req.GetEmail())
+	return s.send(emailShippingUpdate, req.GetEmail(), req.GetIdempotencyKey(), shippingUpdateData{
+		OrderID:  req.GetOrderId(),
+		Shipment: req.GetShipment(),
+	})
+}
+
+// SendPaymentFailed tells the customer their payment did not go through
+func (s *EmailService) SendPaymentFailed(ctx context.Context, req *pb.SendPaymentFailedRequest) (*pb.Empty, error) {
+	log.Printf("SendPaymentFailed request received for email = %v", redact.Email(req.GetEmail()))
+
+	return s.send(emailPaymentFailed, req.GetEmail(), req.GetIdempotencyKey(), paymentFailedData{
+		OrderID: req.GetOrderId(),
+		Amount:  req.GetAmount(),
+		Reason:  req.GetReason(),
+	})
+}
+
+// SendRefundIssued tells the customer a refund is on its way
+func (s *EmailService) SendRefundIssued(ctx context.Context, req *pb.SendRefundIssuedRequest) (*pb.Empty, error) {
+	log.Printf("SendRefundIssued request received for email = %v", redact.Email(req.GetEmail()))
+
+	return s.send(emailRefundIssued, req.GetEmail(), req.GetIdempotencyKey(), refundIssuedData{
+		OrderID:       req.GetOrderId(),
+		TransactionID: req.GetTransactionId(),
+		Amount:        req.GetAmount(),
+	})
+}
+
+// SendAbandonedCartReminder reminds the customer of items left in their cart
+func (s *EmailService) SendAbandonedCartReminder(ctx context.Context, req *pb.SendAbandonedCartReminderRequest) (*pb.Empty, error) {
+	log.Printf("SendAbandonedCartReminder request received for email = %v", redact.Email(req.GetEmail()))
+
+	if len(req.GetItems()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "abandoned cart reminder needs at least one item")
+	}
+	return s.send(emailAbandonedCart, req.GetEmail(), req.GetIdempotencyKey(), abandonedCartData{
+		Items:   req.GetItems(),
+		CartURL: req.GetCartUrl(),
+	})
+}
+
+// SendProductAlert tells a subscriber that a product got cheaper or is back
+// in stock
+func (s *EmailService) SendProductAlert(ctx context.Context, req *pb.SendProductAlertRequest) (*pb.Empty, error) {
+	log.Printf("SendProductAlert request received for email = %v, product_id = %v, kind = %v", redact.Email(req.GetEmail()), req.GetProductId(), req.GetKind())
+
+	data := productAlertData{
+		ProductName:    req.GetProductName(),
+		ProductURL:     req.GetProductUrl(),
+		UnsubscribeURL: req.GetUnsubscribeUrl(),
+	}
+	switch req.GetKind() {
+	case pb.ProductAlertKind_PRODUCT_ALERT_KIND_PRICE_DROP:
+		if req.GetOldPrice() == nil || req.GetNewPrice() == nil {
+			return nil, status.Error(codes.InvalidArgument, "price drop alert needs old_price and new_price")
+		}
+		data.PriceDrop = true
+		data.OldPrice = req.GetOldPrice()
+		data.NewPrice = req.GetNewPrice()
+	case pb.ProductAlertKind_PRODUCT_ALERT_KIND_BACK_IN_STOCK:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown alert kind %v", req.GetKind())
+	}
+	if data.ProductName == "" {
+		data.ProductName = req.GetProductId()
+	}
+	return s.send(emailProductAlert, req.GetEmail(), req.GetIdempotencyKey(), data)
+}
+
+// PreviewEmail renders a template with sample data
+func (s *EmailService) PreviewEmail(ctx context.Context, req *pb.PreviewEmailRequest) (*pb.EmailPreview, error) {
+	tmpl, ok := s.templates[req.GetTemplate()]
+	data, hasSample := sampleEmailData(req.GetTemplate())
+	if !ok || !hasSample {
+		return nil, status.Errorf(codes.NotFound, "unknown template %q; templates are %s",
+			req.GetTemplate(), strings.Join(emailTemplateNames, ", "))
+	}
+	msg, err := tmpl.render(s.from, "customer@example.com", data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to render %s: %v", req.GetTemplate(), err)
+	}
+	return &pb.EmailPreview{Subject: msg.Subject, Text: msg.Text, Html: msg.HTML}, nil
+}
+
+// send renders the named template for to and queues it under key.
+func (s *EmailService) send(name, to, key string, data interface{}) (*pb.Empty, error) {
+	if to == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+	if key == "" {
+		return nil, status.Error(codes.InvalidArgument, "idempotency_key is required")
+	}
+	// Only a parsed address goes into the To header, so that a caller
+	// cannot add headers of its own with a line break.
+	addr, err := mail.ParseAddress(to)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email: %v", err)
+	}
+
+	msg, err := s.templates[name].render(s.from, addr.String(), data)
+	if err != nil {
+		log.Printf("Error executing template %s: %v", name, err)
+		return nil, status.Errorf(codes.Internal, "failed to render %s: %v", name, err)
+	}
+
+	id, duplicate, err := s.queue.enqueue(msg, key)
+	if err != nil {
+		log.Printf("Failed to queue %s email to %v: %v", name, redact.Email(to), err)
+		return nil, status.Errorf(codes.Unavailable, "failed to queue email: %v", err)
+	}
+	if duplicate {
+		log.Printf("Ignoring repeated %s email for key %q, already queued as %s", name, key, id)
+	} else {
+		log.Printf("%s email %s queued for %v", name, id, redact.Email(to))
+	}
 
 	return &pb.Empty{}, nil
 }
+
+// GetQueueStatus reports the delivery queue's depth, counters, latency and
+// dead letters
+func (s *EmailService) GetQueueStatus(ctx context.Context, req *pb.GetQueueStatusRequest) (*pb.EmailQueueStatus, error) {
+	return s.queue.status(), nil
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/deskchen/online-boutique-grpc/services/redact"
+)
+
+const defaultEmailFrom = "Online Boutique <no-reply@onlineboutique.example>"
+
+// mailMessage is a rendered email with plain text and HTML alternatives.
+type mailMessage struct {
+	From    string
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Mailer delivers rendered email.
+type Mailer interface {
+	Send(ctx context.Context, msg *mailMessage) error
+}
+
+// newMailerFromEnv picks the mailer named by EMAIL_MAILER ("log" by default,
+// "smtp" or "maildir") and configures it from the environment.
+func newMailerFromEnv() (Mailer, error) {
+	switch m := strings.ToLower(os.Getenv("EMAIL_MAILER")); m {
+	case "", "log":
+		log.Printf("Using log mailer")
+		return logMailer{}, nil
+	case "smtp":
+		var addr string
+		mustMapEnv(&addr, "EMAIL_SMTP_ADDR")
+		log.Printf("Using SMTP mailer at %s", addr)
+		return newSMTPMailer(addr, os.Getenv("EMAIL_SMTP_USERNAME"), os.Getenv("EMAIL_SMTP_PASSWORD")), nil
+	case "maildir":
+		var dir string
+		mustMapEnv(&dir, "EMAIL_MAILDIR")
+		log.Printf("Using maildir mailer at %s", dir)
+		return newMaildirMailer(dir), nil
+	default:
+		return nil, fmt.Errorf("unknown EMAIL_MAILER %q", m)
+	}
+}
+
+// bytes renders msg as an RFC 5322 message with a multipart/alternative
+// body.
+func (msg *mailMessage) bytes(now time.Time) ([]byte, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	} {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(pw)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	// Re-format the addresses rather than trusting them to be single-line.
+	from, err := mail.ParseAddress(msg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid From address: %w", err)
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid To address: %w", err)
+	}
+
+	var out bytes.Buffer
+	for _, h := range [][2]string{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", msg.Subject)},
+		{"Date", now.Format(time.RFC1123Z)},
+		{"Message-ID", fmt.Sprintf("<%s@onlineboutique>", uuid.New())},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "multipart/alternative; boundary=" + mw.Boundary()},
+	} {
+		fmt.Fprintf(&out, "%s: %s\r\n", h[0], h[1])
+	}
+	out.WriteString("\r\n")
+	out.Write(body.Bytes())
+	return out.Bytes(), nil
+}
+
+// logMailer only logs that a message would have been sent. Bodies are not
+// logged since they contain the shipping address.
+type logMailer struct{}
+
+func (logMailer) Send(ctx context.Context, msg *mailMessage) error {
+	log.Printf("Email to %s: %q (%d bytes text, %d bytes HTML)",
+		redact.Email(msg.To), msg.Subject, len(msg.Text), len(msg.HTML))
+	return nil
+}
+
+// smtpMailer delivers through an SMTP relay, upgrading to TLS when the relay
+// offers STARTTLS.
+type smtpMailer struct {
+	addr string
+	auth smtp.Auth
+}
+
+func newSMTPMailer(addr, username, password string) *smtpMailer {
+	m := &smtpMailer{addr: addr}
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m
+}
+
+func (m *smtpMailer) Send(ctx context.Context, msg *mailMessage) error {
+	from, err := mail.ParseAddress(msg.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender %q: %w", msg.From, err)
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	data, err := msg.bytes(time.Now())
+	if err != nil {
+		return err
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	host, _, _ := net.SplitHostPort(m.addr)
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if m.auth != nil {
+		if err := c.Auth(m.auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(from.Address); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to.Address); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// maildirMailer drops each message into a Maildir, writing it under tmp/
+// and renaming it into new/ so that readers never see a partial file.
+type maildirMailer struct {
+	dir string
+	seq atomic.Uint64
+}
+
+func newMaildirMailer(dir string) *maildirMailer {
+	return &maildirMailer{dir: dir}
+}
+
+func (m *maildirMailer) Send(ctx context.Context, msg *mailMessage) error {
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(m.dir, sub), 0o700); err != nil {
+			return err
+		}
+	}
+	now := time.Now()
+	data, err := msg.bytes(now)
+	if err != nil {
+		return err
+	}
+
+	host, _ := os.Hostname()
+	host = strings.NewReplacer("/", `\057`, ":", `\072`).Replace(host)
+	name := fmt.Sprintf("%d.M%dP%dQ%d.%s", now.Unix(), now.Nanosecond()/1000, os.Getpid(), m.seq.Add(1), host)
+
+	tmp := filepath.Join(m.dir, "tmp", name)
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(m.dir, "new", name)); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
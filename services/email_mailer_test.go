@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// smtpStub is an in-process SMTP relay that offers AUTH PLAIN but not
+// STARTTLS and records one session.
+type smtpStub struct {
+	addr string
+	// rejectAuth makes AUTH fail as for bad credentials.
+	rejectAuth bool
+
+	mu       sync.Mutex
+	commands []string
+	auth     string
+	data     string
+	done     chan struct{}
+}
+
+func newSMTPStub(t *testing.T, rejectAuth bool) *smtpStub {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smtpStub{addr: lis.Addr().String(), rejectAuth: rejectAuth, done: make(chan struct{})}
+	go func() {
+		defer close(s.done)
+		conn, err := lis.Accept()
+		lis.Close()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s.serve(textproto.NewConn(conn))
+	}()
+	t.Cleanup(func() { lis.Close(); <-s.done })
+	return s
+}
+
+func (s *smtpStub) serve(c *textproto.Conn) {
+	c.PrintfLine("220 localhost ESMTP stub")
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		verb = strings.ToUpper(verb)
+		s.mu.Lock()
+		s.commands = append(s.commands, verb)
+		s.mu.Unlock()
+		switch verb {
+		case "EHLO":
+			c.PrintfLine("250-localhost")
+			c.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			mech, resp, _ := strings.Cut(arg, " ")
+			decoded, _ := base64.StdEncoding.DecodeString(resp)
+			s.mu.Lock()
+			s.auth = mech + " " + string(decoded)
+			s.mu.Unlock()
+			if s.rejectAuth {
+				c.PrintfLine("535 5.7.8 Authentication credentials invalid")
+			} else {
+				c.PrintfLine("235 2.7.0 Authentication successful")
+			}
+		case "MAIL", "RCPT", "RSET", "NOOP":
+			s.mu.Lock()
+			s.commands[len(s.commands)-1] = line
+			s.mu.Unlock()
+			c.PrintfLine("250 OK")
+		case "DATA":
+			c.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.data = string(data)
+			s.mu.Unlock()
+			c.PrintfLine("250 OK: queued")
+		case "QUIT":
+			c.PrintfLine("221 Bye")
+			return
+		default:
+			c.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+func testMail() *mailMessage {
+	return &mailMessage{
+		From:    defaultEmailFrom,
+		To:      "Some One <someone@example.com>",
+		Subject: "Your order — confirmed",
+		Text:    "Thanks for your order!\nTotal: $19.99",
+		HTML:    "<p>Thanks for your order!</p><p>Total: $19.99</p>",
+	}
+}
+
+func TestSMTPMailer(t *testing.T) {
+	stub := newSMTPStub(t, false)
+	m := newSMTPMailer(stub.addr, "user", "secret")
+	if err := m.Send(context.Background(), testMail()); err != nil {
+		t.Fatal(err)
+	}
+	<-stub.done
+
+	want := []string{
+		"EHLO",
+		"AUTH",
+		"MAIL FROM:<no-reply@onlineboutique.example>",
+		"RCPT TO:<someone@example.com>",
+		"DATA",
+		"QUIT",
+	}
+	if strings.Join(stub.commands, "\n") != strings.Join(want, "\n") {
+		t.Errorf("commands = %q, want %q", stub.commands, want)
+	}
+	if stub.auth != "PLAIN \x00user\x00secret" {
+		t.Errorf("AUTH = %q", stub.auth)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(stub.data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "Your order — confirmed" {
+		t.Errorf("Subject = %q, %v", subject, err)
+	}
+	if got := msg.Header.Get("To"); got != `"Some One" <someone@example.com>` {
+		t.Errorf("To = %q", got)
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q, %v", mediaType, err)
+	}
+	parts := map[string]string{}
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(p) // NextPart undoes the quoted-printable encoding
+		parts[p.Header.Get("Content-Type")] = string(body)
+	}
+	if got := parts["text/plain; charset=utf-8"]; got != testMail().Text {
+		t.Errorf("text part = %q", got)
+	}
+	if got := parts["text/html; charset=utf-8"]; got != testMail().HTML {
+		t.Errorf("HTML part = %q", got)
+	}
+}
+
+func TestSMTPMailerWithoutCredentials(t *testing.T) {
+	stub := newSMTPStub(t, false)
+	if err := newSMTPMailer(stub.addr, "", "").Send(context.Background(), testMail()); err != nil {
+		t.Fatal(err)
+	}
+	<-stub.done
+	for _, c := range stub.commands {
+		if c == "AUTH" || c == "STARTTLS" {
+			t.Errorf("sent %s to a relay without credentials or STARTTLS", c)
+		}
+	}
+}
+
+func TestSMTPMailerAuthRejected(t *testing.T) {
+	stub := newSMTPStub(t, true)
+	err := newSMTPMailer(stub.addr, "user", "wrong").Send(context.Background(), testMail())
+	if err == nil || !strings.Contains(err.Error(), "535") {
+		t.Errorf("Send with rejected credentials: err = %v", err)
+	}
+	<-stub.done
+	for _, c := range stub.commands {
+		if strings.HasPrefix(c, "MAIL") || c == "DATA" {
+			t.Errorf("sent %s after AUTH was rejected", c)
+		}
+	}
+}
+
+func TestNewMailerFromEnv(t *testing.T) {
+	t.Setenv("EMAIL_MAILER", "maildir")
+	t.Setenv("EMAIL_MAILDIR", t.TempDir())
+	m, err := newMailerFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.(*maildirMailer); !ok {
+		t.Errorf("EMAIL_MAILER=maildir gave a %T", m)
+	}
+
+	t.Setenv("EMAIL_MAILER", "carrier-pigeon")
+	if _, err := newMailerFromEnv(); err == nil {
+		t.Error("unknown EMAIL_MAILER accepted")
+	}
+}
@@ -0,0 +1,603 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"container/heap"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+	"github.com/deskchen/online-boutique-grpc/services/redact"
+)
+
+// latencyWindow is how many recent deliveries the latency percentiles cover.
+const latencyWindow = 1024
+
+type emailQueueConfig struct {
+	Workers     int
+	MaxAttempts int
+	// Failed attempts are retried after BaseBackoff, doubling each time up
+	// to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	SendTimeout time.Duration
+	// IdempotencyTTL is how long an idempotency key is remembered.
+	IdempotencyTTL time.Duration
+	// MaxDeadLetters is how many dead letters are kept; older ones are
+	// discarded.
+	MaxDeadLetters int
+}
+
+func emailQueueConfigFromEnv() emailQueueConfig {
+	return emailQueueConfig{
+		Workers:     int(envInt64("EMAIL_WORKERS", 2)),
+		MaxAttempts: int(envInt64("EMAIL_MAX_ATTEMPTS", 8)),
+		BaseBackoff: envDuration("EMAIL_RETRY_BASE", time.Second),
+		MaxBackoff:  envDuration("EMAIL_RETRY_MAX", 5*time.Minute),
+		SendTimeout: envDuration("EMAIL_SEND_TIMEOUT", 30*time.Second),
+
+		IdempotencyTTL: envDuration("EMAIL_IDEMPOTENCY_TTL", 24*time.Hour),
+		MaxDeadLetters: int(envInt64("EMAIL_MAX_DEAD_LETTERS", 1000)),
+	}
+}
+
+// queuedEmail is a rendered message waiting for, or past, delivery.
+type queuedEmail struct {
+	ID             string       `json:"id"`
+	IdempotencyKey string       `json:"idempotencyKey,omitempty"`
+	Message        *mailMessage `json:"message"`
+	Enqueued       time.Time    `json:"enqueued"`
+	Attempts       int          `json:"attempts"`
+	LastError      string       `json:"lastError,omitempty"`
+
+	next  time.Time
+	index int
+}
+
+// idempotencyEntry remembers which message an idempotency key produced.
+type idempotencyEntry struct {
+	ID       string    `json:"id"`
+	Accepted time.Time `json:"accepted"`
+}
+
+// emailHeap orders queued messages by when they are next due.
+type emailHeap []*queuedEmail
+
+func (h emailHeap) Len() int           { return len(h) }
+func (h emailHeap) Less(i, j int) bool { return h[i].next.Before(h[j].next) }
+func (h emailHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+func (h *emailHeap) Push(x interface{}) {
+	e := x.(*queuedEmail)
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+func (h *emailHeap) Pop() interface{} {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return e
+}
+
+// emailQueue accepts messages at once and delivers them from a pool of
+// workers, retrying failures with exponential backoff. Messages that fail
+// MaxAttempts times move to a dead-letter list of at most MaxDeadLetters.
+// With a journal, accepted messages survive a restart.
+type emailQueue struct {
+	mailer  Mailer
+	cfg     emailQueueConfig
+	journal *emailJournal
+	now     func() time.Time
+
+	mu             sync.Mutex
+	pending        emailHeap
+	inFlight       int
+	dead           []*queuedEmail
+	delivered      int64
+	failedAttempts int64
+	latencies      [latencyWindow]time.Duration
+	latencyCount   int
+
+	keys       map[string]idempotencyEntry
+	lastPruned time.Time
+
+	// wake is signalled when a message becomes due sooner than the workers
+	// may be waiting for.
+	wake chan struct{}
+}
+
+// newEmailQueue returns a queue delivering through mailer. If journal is not
+// nil, undelivered and dead messages are reloaded from it.
+func newEmailQueue(mailer Mailer, cfg emailQueueConfig, journal *emailJournal) (*emailQueue, error) {
+	if cfg.Workers < 1 {
+		cfg.Workers = 1
+	}
+	if cfg.MaxAttempts < 1 {
+		cfg.MaxAttempts = 1
+	}
+	if cfg.MaxDeadLetters < 1 {
+		cfg.MaxDeadLetters = 1
+	}
+	q := &emailQueue{
+		mailer:  mailer,
+		cfg:     cfg,
+		journal: journal,
+		now:     time.Now,
+		wake:    make(chan struct{}, 1),
+		keys:    map[string]idempotencyEntry{},
+	}
+	if journal != nil {
+		pending, dead, keys, err := journal.load(time.Now().Add(-cfg.IdempotencyTTL))
+		if err != nil {
+			return nil, err
+		}
+		q.keys = keys
+		for _, e := range pending {
+			heap.Push(&q.pending, e)
+		}
+		q.dead = dead
+		if len(pending) > 0 || len(dead) > 0 {
+			log.Printf("Reloaded %d queued and %d dead-lettered emails", len(pending), len(dead))
+		}
+		// MaxDeadLetters may have been lowered since the journal was
+		// written.
+		q.discard(q.trimDead())
+	}
+	return q, nil
+}
+
+// start runs the workers until ctx is done.
+func (q *emailQueue) start(ctx context.Context) {
+	for i := 0; i < q.cfg.Workers; i++ {
+		go q.work(ctx)
+	}
+}
+
+// enqueue accepts msg for delivery and returns its ID. The message is
+// journaled before enqueue returns. If key was accepted before, nothing is
+// queued and the earlier message's ID is returned with duplicate set. An
+// empty key is never a duplicate.
+func (q *emailQueue) enqueue(msg *mailMessage, key string) (id string, duplicate bool, err error) {
+	e := &queuedEmail{
+		ID:             uuid.New().String(),
+		IdempotencyKey: key,
+		Message:        msg,
+		Enqueued:       q.now(),
+	}
+	e.next = e.Enqueued
+
+	// Reserve the key before journaling so that concurrent retries with the
+	// same key cannot both get through.
+	if key != "" {
+		q.mu.Lock()
+		q.pruneKeys(e.Enqueued)
+		if prev, ok := q.keys[key]; ok {
+			q.mu.Unlock()
+			return prev.ID, true, nil
+		}
+		q.keys[key] = idempotencyEntry{ID: e.ID, Accepted: e.Enqueued}
+		q.mu.Unlock()
+	}
+
+	if q.journal != nil {
+		if err := q.journal.append(journalRecord{Op: "enqueue", Email: e}); err != nil {
+			if key != "" {
+				q.mu.Lock()
+				delete(q.keys, key)
+				q.mu.Unlock()
+			}
+			return "", false, fmt.Errorf("failed to journal email: %w", err)
+		}
+		q.compactJournal()
+	}
+	q.mu.Lock()
+	heap.Push(&q.pending, e)
+	q.mu.Unlock()
+	q.signal()
+	return e.ID, false, nil
+}
+
+// pruneKeys forgets idempotency keys older than the TTL, at most once a
+// minute. q.mu must be held.
+func (q *emailQueue) pruneKeys(now time.Time) {
+	if now.Sub(q.lastPruned) < time.Minute {
+		return
+	}
+	q.lastPruned = now
+	cutoff := now.Add(-q.cfg.IdempotencyTTL)
+	for k, ent := range q.keys {
+		if ent.Accepted.Before(cutoff) {
+			delete(q.keys, k)
+		}
+	}
+}
+
+func (q *emailQueue) signal() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (q *emailQueue) work(ctx context.Context) {
+	for {
+		e := q.take(ctx)
+		if e == nil {
+			return
+		}
+		q.deliver(ctx, e)
+	}
+}
+
+// take blocks until a message is due or ctx is done.
+func (q *emailQueue) take(ctx context.Context) *queuedEmail {
+	for {
+		q.mu.Lock()
+		var timer <-chan time.Time
+		if len(q.pending) > 0 {
+			wait := q.pending[0].next.Sub(q.now())
+			if wait <= 0 {
+				e := heap.Pop(&q.pending).(*queuedEmail)
+				q.inFlight++
+				more := len(q.pending) > 0
+				q.mu.Unlock()
+				// Pass the wake-up on so that an idle worker looks at
+				// what is left.
+				if more {
+					q.signal()
+				}
+				return e
+			}
+			timer = time.After(wait)
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-q.wake:
+		case <-timer:
+		}
+	}
+}
+
+func (q *emailQueue) deliver(ctx context.Context, e *queuedEmail) {
+	sendCtx, cancel := context.WithTimeout(ctx, q.cfg.SendTimeout)
+	err := q.mailer.Send(sendCtx, e.Message)
+	cancel()
+	now := q.now()
+
+	q.mu.Lock()
+	q.inFlight--
+	if err == nil {
+		q.delivered++
+		q.latencies[q.latencyCount%latencyWindow] = now.Sub(e.Enqueued)
+		q.latencyCount++
+		q.mu.Unlock()
+		q.record(journalRecord{Op: "delivered", ID: e.ID})
+		return
+	}
+
+	q.failedAttempts++
+	e.Attempts++
+	e.LastError = err.Error()
+	if e.Attempts >= q.cfg.MaxAttempts {
+		q.dead = append(q.dead, e)
+		discarded := q.trimDead()
+		q.mu.Unlock()
+		log.Printf("Giving up on email %s to %s after %d attempts: %v", e.ID, redact.Email(e.Message.To), e.Attempts, err)
+		q.record(journalRecord{Op: "dead", ID: e.ID, Attempts: e.Attempts, Error: e.LastError})
+		q.discard(discarded)
+		return
+	}
+	e.next = now.Add(q.backoff(e.Attempts))
+	heap.Push(&q.pending, e)
+	q.mu.Unlock()
+	log.Printf("Email %s attempt %d failed, retrying at %s: %v", e.ID, e.Attempts, e.next.Format(time.RFC3339), err)
+	q.record(journalRecord{Op: "failed", ID: e.ID, Attempts: e.Attempts, Error: e.LastError})
+	q.signal()
+}
+
+// backoff returns the delay before retrying after the given number of
+// attempts: BaseBackoff doubled per attempt, capped at MaxBackoff, with up
+// to half of it randomised so that retries of a burst spread out.
+func (q *emailQueue) backoff(attempts int) time.Duration {
+	d := q.cfg.BaseBackoff
+	for i := 1; i < attempts && d < q.cfg.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > q.cfg.MaxBackoff {
+		d = q.cfg.MaxBackoff
+	}
+	if half := int64(d / 2); half > 0 {
+		d = time.Duration(half + rand.Int63n(half+1))
+	}
+	return d
+}
+
+// trimDead removes and returns the oldest dead letters beyond
+// MaxDeadLetters. q.mu must be held.
+func (q *emailQueue) trimDead() []*queuedEmail {
+	n := len(q.dead) - q.cfg.MaxDeadLetters
+	if n <= 0 {
+		return nil
+	}
+	discarded := append([]*queuedEmail(nil), q.dead[:n]...)
+	q.dead = append(q.dead[:0:0], q.dead[n:]...)
+	return discarded
+}
+
+// discard journals that dead letters trimmed by trimDead are gone.
+func (q *emailQueue) discard(emails []*queuedEmail) {
+	for _, e := range emails {
+		log.Printf("Discarding dead-lettered email %s to %s", e.ID, redact.Email(e.Message.To))
+		q.record(journalRecord{Op: "discarded", ID: e.ID})
+	}
+}
+
+func (q *emailQueue) record(r journalRecord) {
+	if q.journal == nil {
+		return
+	}
+	if err := q.journal.append(r); err != nil {
+		log.Printf("Failed to journal email %s %s: %v", r.ID, r.Op, err)
+		return
+	}
+	q.compactJournal()
+}
+
+// compactJournal compacts the journal once it has grown past its
+// threshold. A failure is only logged: the journal is still complete, just
+// longer than it needs to be.
+func (q *emailQueue) compactJournal() {
+	if err := q.journal.compact(q.now().Add(-q.cfg.IdempotencyTTL)); err != nil {
+		log.Printf("Failed to compact email queue journal: %v", err)
+	}
+}
+
+// status returns queue depth, counters, latency percentiles and dead
+// letters.
+func (q *emailQueue) status() *pb.EmailQueueStatus {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n := min(q.latencyCount, latencyWindow)
+	lat := make([]time.Duration, n)
+	copy(lat, q.latencies[:n])
+	sort.Slice(lat, func(i, j int) bool { return lat[i] < lat[j] })
+	percentile := func(p float64) float64 {
+		if n == 0 {
+			return 0
+		}
+		return float64(lat[int(p*float64(n-1))]) / float64(time.Millisecond)
+	}
+
+	st := &pb.EmailQueueStatus{
+		QueueDepth:           int32(len(q.pending)),
+		InFlight:             int32(q.inFlight),
+		Delivered:            q.delivered,
+		FailedAttempts:       q.failedAttempts,
+		DeliveryLatencyP50Ms: percentile(0.50),
+		DeliveryLatencyP95Ms: percentile(0.95),
+		DeliveryLatencyMaxMs: percentile(1),
+	}
+	for _, e := range q.dead {
+		st.DeadLetters = append(st.DeadLetters, &pb.DeadLetter{
+			MessageId:    e.ID,
+			Recipient:    redact.Email(e.Message.To),
+			Subject:      e.Message.Subject,
+			Attempts:     int32(e.Attempts),
+			LastError:    e.LastError,
+			EnqueuedUnix: e.Enqueued.Unix(),
+		})
+	}
+	return st
+}
+
+// journalRecord is one line of the queue journal.
+type journalRecord struct {
+	Op       string       `json:"op"`
+	ID       string       `json:"id,omitempty"`
+	Email    *queuedEmail `json:"email,omitempty"`
+	Attempts int          `json:"attempts,omitempty"`
+	Error    string       `json:"error,omitempty"`
+
+	// Key and Accepted keep the idempotency keys of delivered messages
+	// across compaction.
+	Key      string    `json:"key,omitempty"`
+	Accepted time.Time `json:"accepted,omitempty"`
+}
+
+// emailJournal is an append-only JSON-lines log of queue operations. It is
+// compacted on load, and again whenever it grows past compactBytes, so that
+// delivered messages do not accumulate.
+type emailJournal struct {
+	mu           sync.Mutex
+	path         string
+	compactBytes int64
+
+	// size is the journal's length, and compactAt the length at which it
+	// is next compacted: compactBytes, or twice what is left after a
+	// compaction if that is more, so that a journal of mostly live
+	// messages is not compacted on every append.
+	size      int64
+	compactAt int64
+}
+
+// newEmailJournal returns a journal at path that is compacted whenever it
+// reaches compactBytes. A compactBytes of zero or less compacts it only on
+// load.
+func newEmailJournal(path string, compactBytes int64) *emailJournal {
+	return &emailJournal{path: path, compactBytes: compactBytes}
+}
+
+func (j *emailJournal) append(r journalRecord) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	f, err := os.OpenFile(j.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	n, err := f.Write(append(line, '\n'))
+	j.size += int64(n)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// compact rewrites the journal without delivered messages if it has grown
+// past its threshold. It replays the file rather than taking the queue's
+// state, so appends made while the queue's lock was not held are kept.
+func (j *emailJournal) compact(keysSince time.Time) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.compactBytes <= 0 || j.size < j.compactAt {
+		return nil
+	}
+	pending, dead, keys, err := j.replay(keysSince)
+	if err != nil {
+		return err
+	}
+	return j.rewrite(pending, dead, keys)
+}
+
+// load replays the journal and returns the messages still to deliver and
+// the dead letters, each in the order they were accepted, along with the
+// idempotency keys accepted since keysSince.
+func (j *emailJournal) load(keysSince time.Time) (pending, dead []*queuedEmail, keys map[string]idempotencyEntry, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	pending, dead, keys, err = j.replay(keysSince)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return pending, dead, keys, j.rewrite(pending, dead, keys)
+}
+
+// replay reads the journal back as load does. j.mu must be held.
+func (j *emailJournal) replay(keysSince time.Time) (pending, dead []*queuedEmail, keys map[string]idempotencyEntry, err error) {
+	keys = map[string]idempotencyEntry{}
+	data, err := os.ReadFile(j.path)
+	if os.IsNotExist(err) {
+		return nil, nil, keys, nil
+	} else if err != nil {
+		return nil, nil, nil, err
+	}
+
+	emails := map[string]*queuedEmail{}
+	isDead := map[string]bool{}
+	var order []string
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; sc.Scan(); line++ {
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		var r journalRecord
+		if err := json.Unmarshal(sc.Bytes(), &r); err != nil {
+			return nil, nil, nil, fmt.Errorf("%s:%d: %w", j.path, line, err)
+		}
+		switch r.Op {
+		case "enqueue":
+			if r.Email == nil {
+				return nil, nil, nil, fmt.Errorf("%s:%d: enqueue without email", j.path, line)
+			}
+			emails[r.Email.ID] = r.Email
+			order = append(order, r.Email.ID)
+			if k := r.Email.IdempotencyKey; k != "" && !r.Email.Enqueued.Before(keysSince) {
+				keys[k] = idempotencyEntry{ID: r.Email.ID, Accepted: r.Email.Enqueued}
+			}
+		case "key":
+			if !r.Accepted.Before(keysSince) {
+				keys[r.Key] = idempotencyEntry{ID: r.ID, Accepted: r.Accepted}
+			}
+		case "failed", "dead":
+			if e, ok := emails[r.ID]; ok {
+				e.Attempts, e.LastError = r.Attempts, r.Error
+				isDead[r.ID] = r.Op == "dead"
+			}
+		case "delivered", "discarded":
+			delete(emails, r.ID)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	for _, id := range order {
+		e, ok := emails[id]
+		if !ok {
+			continue
+		}
+		if isDead[id] {
+			dead = append(dead, e)
+		} else {
+			pending = append(pending, e)
+		}
+	}
+	return pending, dead, keys, nil
+}
+
+// rewrite replaces the journal with just the given messages and keys. j.mu
+// must be held.
+func (j *emailJournal) rewrite(pending, dead []*queuedEmail, keys map[string]idempotencyEntry) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	kept := map[string]bool{}
+	for _, e := range append(pending[:len(pending):len(pending)], dead...) {
+		kept[e.ID] = true
+	}
+	for k, ent := range keys {
+		if kept[ent.ID] {
+			continue
+		}
+		if err := enc.Encode(journalRecord{Op: "key", ID: ent.ID, Key: k, Accepted: ent.Accepted}); err != nil {
+			return err
+		}
+	}
+	for _, e := range pending {
+		if err := enc.Encode(journalRecord{Op: "enqueue", Email: e}); err != nil {
+			return err
+		}
+	}
+	for _, e := range dead {
+		if err := enc.Encode(journalRecord{Op: "enqueue", Email: e}); err != nil {
+			return err
+		}
+		if err := enc.Encode(journalRecord{Op: "dead", ID: e.ID, Attempts: e.Attempts, Error: e.LastError}); err != nil {
+			return err
+		}
+	}
+	tmp := j.path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, j.path); err != nil {
+		return err
+	}
+	j.size = int64(buf.Len())
+	j.compactAt = max(j.compactBytes, 2*j.size)
+	return nil
+}
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+	"google.golang.org/grpc"
+)
+
+// mailerFunc adapts a function to Mailer.
+type mailerFunc func(ctx context.Context, msg *mailMessage) error
+
+func (f mailerFunc) Send(ctx context.Context, msg *mailMessage) error { return f(ctx, msg) }
+
+// sendOne delivers the next due message the way a worker would.
+func sendOne(t *testing.T, q *emailQueue) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	e := q.take(ctx)
+	if e == nil {
+		t.Fatal("no message due")
+	}
+	q.deliver(ctx, e)
+}
+
+func testEmailQueueConfig() emailQueueConfig {
+	return emailQueueConfig{
+		MaxAttempts:    1,
+		SendTimeout:    time.Second,
+		IdempotencyTTL: time.Hour,
+		MaxDeadLetters: 1000,
+	}
+}
+
+func TestEmailJournalCompactsAtThreshold(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	const threshold = 4096
+	sent := mailerFunc(func(context.Context, *mailMessage) error { return nil })
+	q, err := newEmailQueue(sent, testEmailQueueConfig(), newEmailJournal(path, threshold))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A message being sent while the journal is compacted must survive it.
+	keep, _, err := q.enqueue(&mailMessage{To: "keep@example.com", Subject: "kept"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := q.take(context.Background()); e == nil || e.ID != keep {
+		t.Fatalf("took %v, want %s", e, keep)
+	}
+
+	for i := 0; i < 200; i++ {
+		if _, _, err := q.enqueue(&mailMessage{To: "churn@example.com", Subject: "delivered"}, ""); err != nil {
+			t.Fatal(err)
+		}
+		sendOne(t, q)
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Size() > threshold {
+		t.Errorf("journal is %d bytes after 200 deliveries, want at most %d", fi.Size(), threshold)
+	}
+
+	pending, dead, _, err := newEmailJournal(path, threshold).load(time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].ID != keep || len(dead) != 0 {
+		t.Errorf("reloaded %d pending and %d dead, want just %s pending", len(pending), len(dead), keep)
+	}
+}
+
+func TestEmailQueueDeadLetterCap(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal")
+	cfg := testEmailQueueConfig()
+	cfg.MaxDeadLetters = 2
+	failing := mailerFunc(func(context.Context, *mailMessage) error { return errors.New("relay down") })
+	q, err := newEmailQueue(failing, cfg, newEmailJournal(path, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for i := 0; i < 4; i++ {
+		id, _, err := q.enqueue(&mailMessage{To: "someone@example.com", Subject: "lost"}, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+		sendOne(t, q)
+	}
+
+	deadIDs := func(st *pb.EmailQueueStatus) []string {
+		var out []string
+		for _, d := range st.GetDeadLetters() {
+			out = append(out, d.GetMessageId())
+		}
+		return out
+	}
+	want := strings.Join(ids[2:], ",")
+	if got := strings.Join(deadIDs(q.status()), ","); got != want {
+		t.Errorf("dead letters = %s, want the newest two %s", got, want)
+	}
+
+	// Discarded dead letters stay discarded after a restart, and a lower
+	// cap applies to what is reloaded.
+	cfg.MaxDeadLetters = 1
+	q, err = newEmailQueue(failing, cfg, newEmailJournal(path, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(deadIDs(q.status()), ","); got != ids[3] {
+		t.Errorf("dead letters after reload = %s, want %s", got, ids[3])
+	}
+	q, err = newEmailQueue(failing, cfg, newEmailJournal(path, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(deadIDs(q.status()), ","); got != ids[3] {
+		t.Errorf("dead letters after second reload = %s, want %s", got, ids[3])
+	}
+}
+
+// fakeEmail serves a fixed queue status.
+type fakeEmail struct {
+	pb.UnimplementedEmailServiceServer
+	status *pb.EmailQueueStatus
+}
+
+func (f fakeEmail) GetQueueStatus(context.Context, *pb.GetQueueStatusRequest) (*pb.EmailQueueStatus, error) {
+	return f.status, nil
+}
+
+func TestEmailMetrics(t *testing.T) {
+	email := fakeEmail{status: &pb.EmailQueueStatus{
+		QueueDepth:           3,
+		InFlight:             1,
+		Delivered:            42,
+		FailedAttempts:       5,
+		DeliveryLatencyP50Ms: 12.5,
+		DeliveryLatencyP95Ms: 80,
+		DeliveryLatencyMaxMs: 1500,
+		DeadLetters:          []*pb.DeadLetter{{MessageId: "a"}, {MessageId: "b"}},
+	}}
+	fe := &frontendServer{
+		emailSvcConn: dialServer(t, func(s *grpc.Server) { pb.RegisterEmailServiceServer(s, email) }),
+	}
+	srv := httptest.NewServer(fe.handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/metrics/email")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /metrics/email: %s: %s", resp.Status, body)
+	}
+	for _, line := range []string{
+		"# TYPE email_queue_depth gauge",
+		"email_queue_depth 3",
+		"email_in_flight 1",
+		"# TYPE email_delivered_total counter",
+		"email_delivered_total 42",
+		"email_failed_attempts_total 5",
+		"email_dead_letters 2",
+		"# TYPE email_delivery_latency_ms summary",
+		`email_delivery_latency_ms{quantile="0.5"} 12.5`,
+		`email_delivery_latency_ms{quantile="0.95"} 80`,
+		`email_delivery_latency_ms{quantile="1"} 1500`,
+	} {
+		if !strings.Contains(string(body), line+"\n") {
+			t.Errorf("metrics missing %q:\n%s", line, body)
+		}
+	}
+}
+
+func TestEmailQueueConfigFromEnv(t *testing.T) {
+	t.Setenv("EMAIL_WORKERS", "4")
+	t.Setenv("EMAIL_MAX_ATTEMPTS", "2.7") // not a whole number: ignored
+	cfg := emailQueueConfigFromEnv()
+	if cfg.Workers != 4 || cfg.MaxAttempts != 8 || cfg.MaxDeadLetters != 1000 {
+		t.Errorf("config = %+v, want 4 workers, 8 attempts and 1000 dead letters", cfg)
+	}
+}
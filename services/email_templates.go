@@ -0,0 +1,241 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+const emailTemplatesDir = "templates/email"
+
+// Email template names. Each has a .txt and a .html file in
+// emailTemplatesDir.
+const (
+	emailOrderConfirmation = "order_confirmation"
+	emailShippingUpdate    = "shipping_update"
+	emailPaymentFailed     = "payment_failed"
+	emailRefundIssued      = "refund_issued"
+	emailAbandonedCart     = "abandoned_cart"
+	emailProductAlert      = "product_alert"
+)
+
+var emailTemplateNames = []string{
+	emailOrderConfirmation,
+	emailShippingUpdate,
+	emailPaymentFailed,
+	emailRefundIssued,
+	emailAbandonedCart,
+	emailProductAlert,
+}
+
+// emailFuncs are available to every email template.
+var emailFuncs = map[string]interface{}{
+	"renderMoney":    renderMoney,
+	"cartSize":       cartSize,
+	"inc":            func(i int) int { return i + 1 },
+	"shipmentStatus": shipmentStatusText,
+}
+
+// emailTemplate is one kind of email. The plain text template also defines
+// "subject".
+type emailTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// loadEmailTemplates loads every template in emailTemplateNames from dir.
+func loadEmailTemplates(dir string) (map[string]*emailTemplate, error) {
+	tmpls := make(map[string]*emailTemplate, len(emailTemplateNames))
+	for _, name := range emailTemplateNames {
+		t, err := loadEmailTemplate(dir, name)
+		if err != nil {
+			return nil, err
+		}
+		tmpls[name] = t
+	}
+	return tmpls, nil
+}
+
+// loadEmailTemplate parses name.txt and name.html from dir.
+func loadEmailTemplate(dir, name string) (*emailTemplate, error) {
+	text, err := texttemplate.New(name + ".txt").Funcs(emailFuncs).ParseFiles(filepath.Join(dir, name+".txt"))
+	if err != nil {
+		return nil, err
+	}
+	if text.Lookup("subject") == nil {
+		return nil, fmt.Errorf("%s.txt does not define a subject", name)
+	}
+	html, err := htmltemplate.New(name + ".html").Funcs(emailFuncs).ParseFiles(filepath.Join(dir, name+".html"))
+	if err != nil {
+		return nil, err
+	}
+	return &emailTemplate{text: text, html: html}, nil
+}
+
+// render executes the template into a message for to.
+func (t *emailTemplate) render(from, to string, data interface{}) (*mailMessage, error) {
+	var subject, text, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, err
+	}
+	if err := t.text.Execute(&text, data); err != nil {
+		return nil, err
+	}
+	if err := t.html.Execute(&html, data); err != nil {
+		return nil, err
+	}
+	return &mailMessage{
+		From:    from,
+		To:      to,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+// orderConfirmationData is what the order confirmation templates render.
+type orderConfirmationData struct {
+	Order *pb.OrderResult
+	// Total is nil if the order's amounts cannot be added up.
+	Total *pb.Money
+}
+
+type shippingUpdateData struct {
+	OrderID  string
+	Shipment *pb.Shipment
+}
+
+type paymentFailedData struct {
+	OrderID string
+	Amount  *pb.Money
+	Reason  string
+}
+
+type refundIssuedData struct {
+	OrderID       string
+	TransactionID string
+	Amount        *pb.Money
+}
+
+type abandonedCartData struct {
+	Items   []*pb.CartItem
+	CartURL string
+}
+
+type productAlertData struct {
+	// PriceDrop is false for back-in-stock alerts.
+	PriceDrop      bool
+	ProductName    string
+	ProductURL     string
+	OldPrice       *pb.Money
+	NewPrice       *pb.Money
+	UnsubscribeURL string
+}
+
+// orderTotal adds up the items and shipping of an order.
+func orderTotal(order *pb.OrderResult) (*pb.Money, error) {
+	total := order.GetShippingCost()
+	for _, it := range order.GetItems() {
+		var err error
+		total, err = Sum(total, MultiplySlow(it.GetCost(), uint32(it.GetItem().GetQuantity())))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return total, nil
+}
+
+func shipmentStatusText(s pb.ShipmentStatus) string {
+	switch s {
+	case pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED:
+		return "label created"
+	case pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT:
+		return "in transit"
+	case pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED:
+		return "delivered"
+	default:
+		return "processing"
+	}
+}
+
+// sampleEmailData returns made-up data for previewing a template.
+func sampleEmailData(name string) (interface{}, bool) {
+	usd := func(units int64, nanos int32) *pb.Money {
+		return &pb.Money{CurrencyCode: "USD", Units: units, Nanos: nanos}
+	}
+	items := []*pb.CartItem{
+		{ProductId: "OLJCESPC7Z", Quantity: 1},
+		{ProductId: "66VCHSJNUP", Sku: "66VCHSJNUP-M", Quantity: 2},
+	}
+	now := time.Now()
+
+	switch name {
+	case emailOrderConfirmation:
+		order := &pb.OrderResult{
+			OrderId:            "00000000-0000-0000-0000-000000000000",
+			ShippingTrackingId: "KX-4821-0937-5512",
+			ShippingCost:       usd(8, 990000000),
+			ShippingAddress: &pb.Address{
+				StreetAddress: "1600 Amphitheatre Parkway",
+				City:          "Mountain View",
+				State:         "CA",
+				Country:       "US",
+				PostalCode:    "94043",
+			},
+			Items: []*pb.OrderItem{
+				{Item: items[0], Cost: usd(19, 990000000)},
+				{Item: items[1], Cost: usd(18, 990000000)},
+			},
+			ShippingServiceLevel:  "standard",
+			EstimatedDeliveryDate: addBusinessDays(now, 3).Format("2006-01-02"),
+			Packages: []*pb.Package{
+				{TrackingId: "KX-4821-0937-5512", Warehouse: "San Francisco, CA", Items: items},
+			},
+		}
+		total, _ := orderTotal(order)
+		return orderConfirmationData{Order: order, Total: total}, true
+	case emailShippingUpdate:
+		return shippingUpdateData{
+			OrderID: "00000000-0000-0000-0000-000000000000",
+			Shipment: &pb.Shipment{
+				TrackingId:            "KX-4821-0937-5512",
+				Status:                pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT,
+				ServiceLevel:          "standard",
+				EstimatedDeliveryDate: addBusinessDays(now, 2).Format("2006-01-02"),
+				Warehouse:             "San Francisco, CA",
+				Items:                 items,
+			},
+		}, true
+	case emailPaymentFailed:
+		return paymentFailedData{
+			OrderID: "00000000-0000-0000-0000-000000000000",
+			Amount:  usd(66, 960000000),
+			Reason:  "Your card was declined.",
+		}, true
+	case emailRefundIssued:
+		return refundIssuedData{
+			OrderID:       "00000000-0000-0000-0000-000000000000",
+			TransactionID: "00000000-0000-0000-0000-000000000001",
+			Amount:        usd(19, 990000000),
+		}, true
+	case emailAbandonedCart:
+		return abandonedCartData{Items: items, CartURL: "https://onlineboutique.example/cart"}, true
+	case emailProductAlert:
+		return productAlertData{
+			PriceDrop:      true,
+			ProductName:    "Sunglasses",
+			ProductURL:     "https://onlineboutique.example/product/OLJCESPC7Z",
+			OldPrice:       usd(19, 990000000),
+			NewPrice:       usd(14, 990000000),
+			UnsubscribeURL: "https://onlineboutique.example/unsubscribe?token=0123456789abcdef",
+		}, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+func TestEmailRecipientValidation(t *testing.T) {
+	tmpls, err := loadEmailTemplates(emailTemplatesDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	queue, err := newEmailQueue(logMailer{}, testEmailQueueConfig(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &EmailService{from: defaultEmailFrom, queue: queue, templates: tmpls}
+
+	for _, tc := range []struct {
+		email  string
+		wantTo string // the queued To header, or "" if the request is rejected
+	}{
+		{"someone@example.com", "<someone@example.com>"},
+		{"Some One <someone@example.com>", `"Some One" <someone@example.com>`},
+		{"someone@example.com\r\nBcc: victim@example.com", ""},
+		{"Some One <someone@example.com>\nSubject: free money", ""},
+		{"not an address", ""},
+	} {
+		_, err := s.SendPaymentFailed(context.Background(), &pb.SendPaymentFailedRequest{
+			Email:          tc.email,
+			IdempotencyKey: tc.email,
+			OrderId:        "order",
+			Amount:         usd(10, 0),
+		})
+		if tc.wantTo == "" {
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("SendPaymentFailed(%q): err = %v, want InvalidArgument", tc.email, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("SendPaymentFailed(%q): %v", tc.email, err)
+			continue
+		}
+		if got := queue.pending[len(queue.pending)-1].Message.To; got != tc.wantTo {
+			t.Errorf("SendPaymentFailed(%q) queued To %q, want %q", tc.email, got, tc.wantTo)
+		}
+	}
+	if len(queue.pending) != 2 {
+		t.Errorf("queued %d emails, want 2", len(queue.pending))
+	}
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// dialServer serves the services that register adds in-process and returns
+// a client connection to them.
+func dialServer(t *testing.T, register func(*grpc.Server), opts ...grpc.ServerOption) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(opts...)
+	register(srv)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func usd(units int64, nanos int32) *pb.Money {
+	return &pb.Money{CurrencyCode: "USD", Units: units, Nanos: nanos}
+}
+
+// cardExpirationYear is a year in which test cards have not expired yet.
+var cardExpirationYear = int32(time.Now().Year() + 1)
+
+// fakeCatalog serves a fixed list of products.
+type fakeCatalog struct {
+	pb.UnimplementedProductCatalogServiceServer
+	products []*pb.Product
+}
+
+func (c *fakeCatalog) ListProducts(context.Context, *pb.EmptyUser) (*pb.ListProductsResponse, error) {
+	return &pb.ListProductsResponse{Products: c.products}, nil
+}
+
+func (c *fakeCatalog) GetProduct(_ context.Context, req *pb.GetProductRequest) (*pb.Product, error) {
+	for _, p := range c.products {
+		if p.GetId() == req.GetId() {
+			return p, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "no product with ID %s", req.GetId())
+}
+
+// fakeCart keeps carts in memory.
+type fakeCart struct {
+	pb.UnimplementedCartServiceServer
+	mu    sync.Mutex
+	carts map[string][]*pb.CartItem
+}
+
+func newFakeCart() *fakeCart { return &fakeCart{carts: map[string][]*pb.CartItem{}} }
+
+func (c *fakeCart) AddItem(_ context.Context, req *pb.AddItemRequest) (*pb.Empty, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.carts[req.GetUserId()] = append(c.carts[req.GetUserId()], req.GetItem())
+	return &pb.Empty{}, nil
+}
+
+func (c *fakeCart) GetCart(_ context.Context, req *pb.GetCartRequest) (*pb.Cart, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &pb.Cart{UserId: req.GetUserId(), Items: c.carts[req.GetUserId()]}, nil
+}
+
+func (c *fakeCart) EmptyCart(_ context.Context, req *pb.EmptyCartRequest) (*pb.Empty, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.carts, req.GetUserId())
+	return &pb.Empty{}, nil
+}
+
+func (c *fakeCart) items(userID string) []*pb.CartItem {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.carts[userID]
+}
+
+// fakeCurrency supports USD and EUR, at one euro to the dollar.
+type fakeCurrency struct {
+	pb.UnimplementedCurrencyServiceServer
+}
+
+func (fakeCurrency) GetSupportedCurrencies(context.Context, *pb.EmptyUser) (*pb.GetSupportedCurrenciesResponse, error) {
+	return &pb.GetSupportedCurrenciesResponse{CurrencyCodes: []string{"EUR", "USD"}}, nil
+}
+
+func (fakeCurrency) Convert(_ context.Context, req *pb.CurrencyConversionRequest) (*pb.Money, error) {
+	return &pb.Money{CurrencyCode: req.GetToCode(), Units: req.GetFrom().GetUnits(), Nanos: req.GetFrom().GetNanos()}, nil
+}
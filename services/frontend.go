@@ -5,30 +5,35 @@ import (
 	"fmt"
 	"html/template"
 	"log"
-	"math/rand"
+	"math"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+	"github.com/deskchen/online-boutique-grpc/services/redact"
 	"github.com/deskchen/online-boutique-grpc/services/validator"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/ext"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	// port            = "8080"
 	defaultCurrency = "CNY"
-	// cookieMaxAge    = 60 * 60 * 48
+	cookieMaxAge    = 60 * 60 * 48
 
-	cookiePrefix = "shop_"
-	// cookieSessionID = cookiePrefix + "session-id"
-	cookieCurrency = cookiePrefix + "currency"
+	cookiePrefix    = "shop_"
+	cookieSessionID = cookiePrefix + "session-id"
+	cookieCurrency  = cookiePrefix + "currency"
 )
 
 // type ctxKeyLog struct{}
@@ -48,6 +53,10 @@ var (
 				Funcs(template.FuncMap{
 			"renderMoney":        renderMoney,
 			"renderCurrencyLogo": renderCurrencyLogo,
+			"renderTimestamp":    renderTimestamp,
+			"renderStars":        renderStars,
+			"inc":                func(i int) int { return i + 1 },
+			"cartSize":           cartSize,
 		}).ParseGlob("templates/*.html"))
 	plat platformDetails
 
@@ -86,6 +95,18 @@ type frontendServer struct {
 	adSvcAddr string
 	adSvcConn *grpc.ClientConn
 
+	reviewSvcAddr string
+	reviewSvcConn *grpc.ClientConn
+
+	wishlistSvcAddr string
+	wishlistSvcConn *grpc.ClientConn
+
+	notificationSvcAddr string
+	notificationSvcConn *grpc.ClientConn
+
+	emailSvcAddr string
+	emailSvcConn *grpc.ClientConn
+
 	shoppingAssistantSvcAddr string
 }
 
@@ -104,6 +125,10 @@ func (fe *frontendServer) Run() error {
 	mustMapEnv(&fe.checkoutSvcAddr, "CHECKOUT_SERVICE_ADDR")
 	mustMapEnv(&fe.shippingSvcAddr, "SHIPPING_SERVICE_ADDR")
 	mustMapEnv(&fe.adSvcAddr, "AD_SERVICE_ADDR")
+	mustMapEnv(&fe.reviewSvcAddr, "REVIEW_SERVICE_ADDR")
+	mustMapEnv(&fe.wishlistSvcAddr, "WISHLIST_SERVICE_ADDR")
+	mustMapEnv(&fe.notificationSvcAddr, "NOTIFICATION_SERVICE_ADDR")
+	mustMapEnv(&fe.emailSvcAddr, "EMAIL_SERVICE_ADDR")
 	mustMapEnv(&fe.shoppingAssistantSvcAddr, "SHOPPING_ASSISTANT_SERVICE_ADDR")
 
 	ctx := context.Background()
@@ -114,13 +139,66 @@ func (fe *frontendServer) Run() error {
 	mustConnGRPC(ctx, &fe.shippingSvcConn, fe.shippingSvcAddr)
 	mustConnGRPC(ctx, &fe.checkoutSvcConn, fe.checkoutSvcAddr)
 	mustConnGRPC(ctx, &fe.adSvcConn, fe.adSvcAddr)
-
-	http.HandleFunc("/", fe.tracingMiddleware(fe.homeHandler))
-	http.HandleFunc("/cart/checkout", fe.tracingMiddleware(fe.placeOrderHandler))
-	http.HandleFunc("/cart", fe.tracingMiddleware(fe.addToCartHandler))
+	mustConnGRPC(ctx, &fe.reviewSvcConn, fe.reviewSvcAddr)
+	mustConnGRPC(ctx, &fe.wishlistSvcConn, fe.wishlistSvcAddr)
+	mustConnGRPC(ctx, &fe.notificationSvcConn, fe.notificationSvcAddr)
+	mustConnGRPC(ctx, &fe.emailSvcConn, fe.emailSvcAddr)
 
 	log.Printf("frontendServer server running at port: %d", fe.port)
-	return http.ListenAndServe(fmt.Sprintf(":%d", fe.port), nil)
+	return http.ListenAndServe(fmt.Sprintf(":%d", fe.port), fe.handler())
+}
+
+// handler routes the frontend's pages and gives each request a session.
+func (fe *frontendServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", fe.tracingMiddleware(fe.homeHandler))
+	mux.HandleFunc("/product/{id}", fe.tracingMiddleware(fe.productHandler))
+	mux.HandleFunc("/product/{id}/review", fe.tracingMiddleware(fe.addReviewHandler))
+	mux.HandleFunc("/product/{id}/subscribe", fe.tracingMiddleware(fe.subscribeHandler))
+	mux.HandleFunc("/unsubscribe", fe.tracingMiddleware(fe.unsubscribeHandler))
+	mux.HandleFunc("/cart/checkout", fe.tracingMiddleware(fe.placeOrderHandler))
+	mux.HandleFunc("GET /cart", fe.tracingMiddleware(fe.viewCartHandler))
+	mux.HandleFunc("POST /cart", fe.tracingMiddleware(fe.addToCartHandler))
+	mux.HandleFunc("POST /cart/empty", fe.tracingMiddleware(fe.emptyCartHandler))
+	mux.HandleFunc("/wishlist", fe.tracingMiddleware(fe.wishlistHandler))
+	mux.HandleFunc("/wishlist/add", fe.tracingMiddleware(fe.addToWishlistHandler))
+	mux.HandleFunc("/wishlist/remove", fe.tracingMiddleware(fe.removeFromWishlistHandler))
+	mux.HandleFunc("/wishlist/move", fe.tracingMiddleware(fe.moveToCartHandler))
+	mux.HandleFunc("/track", fe.tracingMiddleware(fe.trackShipmentHandler))
+	mux.HandleFunc("/search", fe.tracingMiddleware(fe.searchHandler))
+	mux.HandleFunc("/ad/click/{id}", fe.tracingMiddleware(fe.adClickHandler))
+	mux.HandleFunc("/metrics/ads", fe.tracingMiddleware(fe.adMetricsHandler))
+	mux.HandleFunc("/metrics/email", fe.tracingMiddleware(fe.emailMetricsHandler))
+	return ensureSessionID(mux)
+}
+
+// ensureSessionID gives every browser a session ID, kept in a cookie, that
+// identifies its cart, wishlist and other per-user state. With
+// ENABLE_SINGLE_SHARED_SESSION=true every request shares one session, so that
+// load generators without a cookie jar can fill a cart and check it out.
+func ensureSessionID(next http.Handler) http.Handler {
+	shared := strings.ToLower(os.Getenv("ENABLE_SINGLE_SHARED_SESSION")) == "true"
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var id string
+		if c, err := r.Cookie(cookieSessionID); err == nil && c.Value != "" {
+			id = c.Value
+		} else {
+			if shared {
+				id = "12345678-1234-1234-1234-123456789123"
+			} else {
+				id = uuid.NewString()
+			}
+			http.SetCookie(w, &http.Cookie{
+				Name:     cookieSessionID,
+				Value:    id,
+				Path:     "/",
+				MaxAge:   cookieMaxAge,
+				HttpOnly: true,
+				SameSite: http.SameSiteLaxMode,
+			})
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ctxKeySessionID{}, id)))
+	})
 }
 
 // tracingMiddleware creates OpenTracing spans for HTTP requests
@@ -134,7 +212,7 @@ func (fe *frontendServer) tracingMiddleware(next http.HandlerFunc) http.HandlerF
 
 		// Set HTTP tags
 		ext.HTTPMethod.Set(span, r.Method)
-		ext.HTTPUrl.Set(span, r.URL.String())
+		ext.HTTPUrl.Set(span, redact.URL(r.URL))
 		ext.Component.Set(span, "frontend")
 
 		// Explicitly set service name
@@ -152,9 +230,38 @@ func (fe *frontendServer) tracingMiddleware(next http.HandlerFunc) http.HandlerF
 	}
 }
 
+// homePageSize is how many products the home page shows at a time.
+const homePageSize = 6
+
+// homeSortOrders maps the home page's sort parameter to a sort order.
+var homeSortOrders = map[string]pb.ProductSortOrder{
+	"":           pb.ProductSortOrder_PRODUCT_SORT_ORDER_UNSPECIFIED,
+	"name":       pb.ProductSortOrder_PRODUCT_SORT_ORDER_NAME,
+	"price_asc":  pb.ProductSortOrder_PRODUCT_SORT_ORDER_PRICE_ASC,
+	"price_desc": pb.ProductSortOrder_PRODUCT_SORT_ORDER_PRICE_DESC,
+}
+
+// homeURL returns the URL of a home page listing.
+func homeURL(sortBy string, categories []string, page string) string {
+	v := url.Values{}
+	if sortBy != "" {
+		v.Set("sort", sortBy)
+	}
+	for _, c := range categories {
+		v.Add("category", c)
+	}
+	if page != "" {
+		v.Set("page", page)
+	}
+	if len(v) == 0 {
+		return "/"
+	}
+	return "/?" + v.Encode()
+}
+
 // homeHandler handles requests to the home page with detailed timing instrumentation
 func (fe *frontendServer) homeHandler(w http.ResponseWriter, r *http.Request) {
-	userId := r.FormValue("user_id")
+	userId := sessionID(r)
 
 	log.Printf("homeHandler: Received request. UserID: %s, Currency: %s", userId, currentCurrency(r))
 
@@ -168,15 +275,32 @@ func (fe *frontendServer) homeHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	log.Printf("homeHandler: Retrieved %d currencies", len(currencies))
 
-	// 2. Retrieve products
-	products, err := fe.getProducts(r.Context(), userId)
+	// 2. Retrieve one page of products
+	sortBy := r.FormValue("sort")
+	sortOrder, ok := homeSortOrders[sortBy]
+	if !ok {
+		renderHTTPError(r, w, errors.Errorf("unknown sort order %q", sortBy), http.StatusBadRequest)
+		return
+	}
+	categories := r.Form["category"]
+	page, err := fe.getProductsPage(r.Context(), &pb.ListProductsRequest{
+		UserId:     userId,
+		PageSize:   homePageSize,
+		PageToken:  r.FormValue("page"),
+		SortOrder:  sortOrder,
+		Categories: categories,
+	})
 
-	if err != nil {
+	if status.Code(err) == codes.InvalidArgument {
+		renderHTTPError(r, w, errors.Wrap(err, "invalid page"), http.StatusBadRequest)
+		return
+	} else if err != nil {
 		log.Printf("homeHandler: Error retrieving products: %v", err)
 		renderHTTPError(r, w, errors.Wrap(err, "could not retrieve products"), http.StatusInternalServerError)
 		return
 	}
-	log.Printf("homeHandler: Retrieved %d products", len(products))
+	products := page.GetProducts()
+	log.Printf("homeHandler: Retrieved %d of %d products", len(products), page.GetTotalSize())
 
 	// 3. Retrieve cart
 	cart, err := fe.getCart(r.Context(), userId)
@@ -199,7 +323,7 @@ func (fe *frontendServer) homeHandler(w http.ResponseWriter, r *http.Request) {
 	currencyConversionErrors := 0
 
 	for i, p := range products {
-		price, err := fe.convertCurrency(r.Context(), p.GetPriceUsd(), currentCurrency(r), userId)
+		price, err := fe.localPrice(r.Context(), p, nil, currentCurrency(r), userId)
 
 		if err != nil {
 			currencyConversionErrors++
@@ -214,6 +338,11 @@ func (fe *frontendServer) homeHandler(w http.ResponseWriter, r *http.Request) {
 
 	log.Printf("homeHandler: Processed %d products with currency conversion", len(ps))
 
+	var nextPage string
+	if page.GetNextPageToken() != "" {
+		nextPage = homeURL(sortBy, categories, page.GetNextPageToken())
+	}
+
 	// 5. Get advertisement
 	ad := fe.chooseAd(r.Context(), []string{}, userId)
 
@@ -225,6 +354,11 @@ func (fe *frontendServer) homeHandler(w http.ResponseWriter, r *http.Request) {
 		"cart_size":     cartSize(cart),
 		"banner_color":  os.Getenv("BANNER_COLOR"), // illustrates canary deployments
 		"ad":            ad,
+		"sort":          sortBy,
+		"categories":    categories,
+		"paged":         r.FormValue("page") != "",
+		"first_page":    homeURL(sortBy, categories, ""),
+		"next_page":     nextPage,
 	}))
 
 	if err != nil {
@@ -238,26 +372,32 @@ func (fe *frontendServer) homeHandler(w http.ResponseWriter, r *http.Request) {
 func (fe *frontendServer) placeOrderHandler(w http.ResponseWriter, r *http.Request) {
 	var (
 		email         = r.FormValue("email")
-		userId        = r.FormValue("user_id")
+		userId        = sessionID(r)
 		streetAddress = r.FormValue("street_address")
-		zipCode, _    = strconv.ParseInt(r.FormValue("zip_code"), 10, 32)
+		postalCode    = strings.TrimSpace(r.FormValue("postal_code"))
 		city          = r.FormValue("city")
 		state         = r.FormValue("state")
 		country       = r.FormValue("country")
 		ccNumber      = r.FormValue("credit_card_number")
 		ccMonth, _    = strconv.ParseInt(r.FormValue("credit_card_expiration_month"), 10, 32)
 		ccYear, _     = strconv.ParseInt(r.FormValue("credit_card_expiration_year"), 10, 32)
-		ccCVV, _      = strconv.ParseInt(r.FormValue("credit_card_cvv"), 10, 32)
+		ccCVV         = strings.TrimSpace(r.FormValue("credit_card_cvv"))
+		serviceLevel  = r.FormValue("shipping_service_level")
 	)
 
-	log.Printf("placeOrderHandler: received input - user_id: %s, email: %s, address: %s, city: %s, state: %s, country: %s, zip code: %d",
-		userId, email, streetAddress, city, state, country, zipCode)
+	// Older clients post the numeric zip_code field.
+	if postalCode == "" {
+		postalCode = strings.TrimSpace(r.FormValue("zip_code"))
+	}
+
+	log.Printf("placeOrderHandler: received input - user_id: %s, email: %s, address: %s, city: %s, state: %s, country: %s, postal code: %s",
+		userId, redact.Email(email), redact.Street(streetAddress), city, state, country, postalCode)
 
 	// 1. Validate payload
 	payload := validator.PlaceOrderPayload{
 		Email:         email,
 		StreetAddress: streetAddress,
-		ZipCode:       zipCode,
+		PostalCode:    postalCode,
 		City:          city,
 		State:         state,
 		Country:       country,
@@ -265,6 +405,8 @@ func (fe *frontendServer) placeOrderHandler(w http.ResponseWriter, r *http.Reque
 		CcMonth:       ccMonth,
 		CcYear:        ccYear,
 		CcCVV:         ccCVV,
+
+		ShippingServiceLevel: serviceLevel,
 	}
 	validationErr := payload.Validate()
 
@@ -283,26 +425,40 @@ func (fe *frontendServer) placeOrderHandler(w http.ResponseWriter, r *http.Reque
 				CreditCardNumber:          payload.CcNumber,
 				CreditCardExpirationMonth: int32(payload.CcMonth),
 				CreditCardExpirationYear:  int32(payload.CcYear),
-				CreditCardCvv:             int32(payload.CcCVV)},
+				CreditCardCvvDigits:       payload.CcCVV},
 			UserId:       sessionID(r),
 			UserCurrency: currentCurrency(r),
 			Address: &pb.Address{
 				StreetAddress: payload.StreetAddress,
 				City:          payload.City,
 				State:         payload.State,
-				ZipCode:       int32(payload.ZipCode),
+				PostalCode:    payload.PostalCode,
 				Country:       payload.Country},
+			ShippingServiceLevel: payload.ShippingServiceLevel,
 		})
 
 	if err != nil {
 		log.Printf("placeOrderHandler: error placing order: %v", err)
-		renderHTTPError(r, w, errors.Wrap(err, "failed to complete the order"), http.StatusInternalServerError)
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.InvalidArgument {
+			code = http.StatusUnprocessableEntity
+		}
+		renderHTTPError(r, w, errors.Wrap(err, "failed to complete the order"), code)
 		return
 	}
 	log.Printf("placeOrderHandler: order placed successfully, Order ID: %s", order.GetOrder().GetOrderId())
 
-	// 3. Get recommendations
-	recommendations, recErr := fe.getRecommendations(r.Context(), sessionID(r), nil)
+	// 3. Get recommendations related to what was just bought
+	var orderedIDs []string
+	for _, it := range order.GetOrder().GetItems() {
+		orderedIDs = append(orderedIDs, it.GetItem().GetProductId())
+	}
+	recommendations, recErr := fe.getRecommendations(r.Context(), &pb.ListRecommendationsRequest{
+		UserId:         sessionID(r),
+		ProductIds:     orderedIDs,
+		Context:        pb.RecommendationContext_RECOMMENDATION_CONTEXT_POST_PURCHASE,
+		MaxPerCategory: 2,
+	})
 
 	if recErr != nil {
 		log.Printf("placeOrderHandler: error retrieving recommendations: %v", recErr)
@@ -313,8 +469,8 @@ func (fe *frontendServer) placeOrderHandler(w http.ResponseWriter, r *http.Reque
 			log.Println("placeOrderHandler: No recommendations available")
 		} else {
 			for i, rec := range recommendations {
-				log.Printf("Recommendation #%d: ID=%s, Name=%s, Description=%s, Picture=%s, PriceUSD=%v, Categories=%v",
-					i+1, rec.Id, rec.Name, rec.Description, rec.Picture, rec.PriceUsd, rec.Categories)
+				log.Printf("Recommendation #%d: ID=%s, Name=%s, Description=%s, Picture=%s, PriceUSD=%v, Categories=%v, Reason=%q",
+					i+1, rec.Id, rec.Name, rec.Description, rec.Picture, rec.PriceUsd, rec.Categories, rec.Reason)
 			}
 		}
 	}
@@ -356,16 +512,286 @@ func (fe *frontendServer) placeOrderHandler(w http.ResponseWriter, r *http.Reque
 	// Log comprehensive timing analysis
 }
 
+// variantView is one choice in the product page's variant picker.
+type variantView struct {
+	Sku      string
+	Label    string
+	Price    *pb.Money
+	Selected bool
+}
+
+// variantLabel describes a variant by its attributes, e.g. "Size: M".
+func variantLabel(v *pb.ProductVariant) string {
+	parts := make([]string, len(v.GetAttributes()))
+	for i, a := range v.GetAttributes() {
+		parts[i] = a.GetName() + ": " + a.GetValue()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// reviewsPageSize is how many reviews the product page shows at a time.
+const reviewsPageSize = 5
+
+// productHandler shows a product with a picker for its variants, and its
+// reviews. The sku parameter selects a variant, the first one by default,
+// and the reviews parameter a page of reviews.
+func (fe *frontendServer) productHandler(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	userId := sessionID(r)
+	log.Printf("productHandler: Received product_id=%s, sku=%s", id, r.FormValue("sku"))
+
+	// 1. Retrieve the product and selected variant
+	p, err := fe.getProduct(r.Context(), id)
+	if status.Code(err) == codes.NotFound {
+		renderHTTPError(r, w, errors.Errorf("product %s not found", id), http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Printf("productHandler: Error retrieving product_id=%s: %v", id, err)
+		renderHTTPError(r, w, errors.Wrap(err, "could not retrieve product"), http.StatusInternalServerError)
+		return
+	}
+	sku := r.FormValue("sku")
+	if sku == "" && len(p.GetVariants()) > 0 {
+		sku = p.GetVariants()[0].GetSku()
+	}
+	variant, err := findVariant(p, sku)
+	if err != nil {
+		renderHTTPError(r, w, err, http.StatusNotFound)
+		return
+	}
+
+	// 2. Retrieve currencies and cart
+	currencies, err := fe.getCurrencies(r.Context(), userId)
+	if err != nil {
+		log.Printf("productHandler: Error retrieving currencies: %v", err)
+		renderHTTPError(r, w, errors.Wrap(err, "could not retrieve currencies"), http.StatusInternalServerError)
+		return
+	}
+	cart, err := fe.getCart(r.Context(), sessionID(r))
+	if err != nil {
+		log.Printf("productHandler: Error retrieving cart: %v", err)
+		renderHTTPError(r, w, errors.Wrap(err, "could not retrieve cart"), http.StatusInternalServerError)
+		return
+	}
+
+	// 3. Price the product, and the variants that override its price, in the
+	// user's currency
+	price, err := fe.localPrice(r.Context(), p, nil, currentCurrency(r), userId)
+	if err != nil {
+		renderHTTPError(r, w, errors.Wrapf(err, "failed to do currency conversion for product %s", id), http.StatusInternalServerError)
+		return
+	}
+	selectedPrice := price
+	variants := make([]variantView, len(p.GetVariants()))
+	for i, v := range p.GetVariants() {
+		vv := variantView{Sku: v.GetSku(), Label: variantLabel(v), Price: price, Selected: v == variant}
+		if v.GetPriceUsd() != nil {
+			if vv.Price, err = fe.localPrice(r.Context(), p, v, currentCurrency(r), userId); err != nil {
+				renderHTTPError(r, w, errors.Wrapf(err, "failed to do currency conversion for SKU %s", v.GetSku()), http.StatusInternalServerError)
+				return
+			}
+		}
+		if vv.Selected {
+			selectedPrice = vv.Price
+		}
+		variants[i] = vv
+	}
+
+	// 4. Get reviews, recommendations and an advertisement; none is critical
+	rating, reviews, err := fe.getReviews(r.Context(), id, r.FormValue("reviews"))
+	if err != nil {
+		log.Printf("productHandler: Error retrieving reviews: %v", err)
+	}
+	var olderReviews string
+	if reviews.GetNextPageToken() != "" {
+		v := url.Values{"reviews": {reviews.GetNextPageToken()}}
+		if r.FormValue("sku") != "" {
+			v.Set("sku", r.FormValue("sku"))
+		}
+		olderReviews = "/product/" + url.PathEscape(id) + "?" + v.Encode()
+	}
+	// Don't recommend what is already in the cart, nor a row of one kind
+	// of product.
+	inCart := make([]string, len(cart))
+	for i, it := range cart {
+		inCart[i] = it.GetProductId()
+	}
+	recommendations, err := fe.getRecommendations(r.Context(), &pb.ListRecommendationsRequest{
+		UserId:            sessionID(r),
+		ProductIds:        []string{id},
+		ExcludeProductIds: inCart,
+		Context:           pb.RecommendationContext_RECOMMENDATION_CONTEXT_PRODUCT,
+		MaxPerCategory:    2,
+	})
+	if err != nil {
+		log.Printf("productHandler: Error retrieving recommendations: %v", err)
+	}
+	ad := fe.chooseAd(r.Context(), p.GetCategories(), userId)
+
+	// 5. Render template
+	err = templates.ExecuteTemplate(w, "product", injectCommonTemplateData(r, map[string]interface{}{
+		"show_currency": true,
+		"currencies":    currencies,
+		"product": struct {
+			Item  *pb.Product
+			Price *pb.Money
+		}{p, selectedPrice},
+		"picture":         variantPicture(p, variant),
+		"sku":             sku,
+		"variants":        variants,
+		"rating":          rating,
+		"rating_stars":    int32(math.Round(rating.GetAverageRating())),
+		"reviews":         reviews.GetReviews(),
+		"older_reviews":   olderReviews,
+		"subscribed":      r.FormValue("subscribed") != "",
+		"recommendations": recommendations,
+		"cart_size":       cartSize(cart),
+		"ad":              ad,
+	}))
+	if err != nil {
+		log.Printf("productHandler: Error rendering template: %v", err)
+	}
+}
+
+// addReviewHandler adds the user's review of a product and goes back to the
+// product page
+func (fe *frontendServer) addReviewHandler(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	rating, _ := strconv.ParseInt(r.FormValue("rating"), 10, 32)
+	log.Printf("addReviewHandler: Received product_id=%s, rating=%d", id, rating)
+
+	payload := validator.AddReviewPayload{
+		Rating:     rating,
+		AuthorName: strings.TrimSpace(r.FormValue("author_name")),
+		Title:      strings.TrimSpace(r.FormValue("title")),
+		Body:       strings.TrimSpace(r.FormValue("body")),
+	}
+	if err := payload.Validate(); err != nil {
+		renderHTTPError(r, w, validator.ValidationErrorResponse(err), http.StatusUnprocessableEntity)
+		return
+	}
+
+	_, err := pb.NewReviewServiceClient(fe.reviewSvcConn).AddReview(r.Context(), &pb.AddReviewRequest{
+		UserId:     sessionID(r),
+		ProductId:  id,
+		AuthorName: payload.AuthorName,
+		Rating:     int32(payload.Rating),
+		Title:      payload.Title,
+		Body:       payload.Body,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument:
+		renderHTTPError(r, w, errors.Wrap(err, "invalid review"), http.StatusUnprocessableEntity)
+		return
+	case codes.NotFound:
+		renderHTTPError(r, w, errors.Errorf("product %s not found", id), http.StatusNotFound)
+		return
+	case codes.AlreadyExists:
+		renderHTTPError(r, w, errors.New("you have already reviewed this product"), http.StatusConflict)
+		return
+	default:
+		renderHTTPError(r, w, errors.Wrap(err, "failed to add review"), http.StatusInternalServerError)
+		return
+	}
+	log.Printf("addReviewHandler: Added review of product_id=%s", id)
+
+	w.Header().Set("location", "/product/"+url.PathEscape(id))
+	w.WriteHeader(http.StatusFound)
+}
+
+// subscribeHandler subscribes an email address to alerts about a product and
+// goes back to the product page
+func (fe *frontendServer) subscribeHandler(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	log.Printf("subscribeHandler: Received product_id=%s", id)
+
+	payload := validator.SubscribePayload{Email: strings.TrimSpace(r.FormValue("email"))}
+	if err := payload.Validate(); err != nil {
+		renderHTTPError(r, w, validator.ValidationErrorResponse(err), http.StatusUnprocessableEntity)
+		return
+	}
+	var kinds []pb.ProductAlertKind
+	if r.FormValue("price_drop") != "" {
+		kinds = append(kinds, pb.ProductAlertKind_PRODUCT_ALERT_KIND_PRICE_DROP)
+	}
+	if r.FormValue("back_in_stock") != "" {
+		kinds = append(kinds, pb.ProductAlertKind_PRODUCT_ALERT_KIND_BACK_IN_STOCK)
+	}
+	if len(kinds) == 0 {
+		renderHTTPError(r, w, errors.New("choose at least one kind of alert"), http.StatusUnprocessableEntity)
+		return
+	}
+
+	_, err := pb.NewNotificationServiceClient(fe.notificationSvcConn).Subscribe(r.Context(), &pb.SubscribeRequest{
+		Email:     payload.Email,
+		ProductId: id,
+		Kinds:     kinds,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument:
+		renderHTTPError(r, w, errors.Wrap(err, "invalid subscription"), http.StatusUnprocessableEntity)
+		return
+	case codes.NotFound:
+		renderHTTPError(r, w, errors.Errorf("product %s not found", id), http.StatusNotFound)
+		return
+	default:
+		renderHTTPError(r, w, errors.Wrap(err, "failed to subscribe"), http.StatusInternalServerError)
+		return
+	}
+
+	v := url.Values{"subscribed": {"1"}}
+	if r.FormValue("sku") != "" {
+		v.Set("sku", r.FormValue("sku"))
+	}
+	w.Header().Set("location", "/product/"+url.PathEscape(id)+"?"+v.Encode())
+	w.WriteHeader(http.StatusFound)
+}
+
+// unsubscribeHandler is where alert emails link to. GET asks for
+// confirmation, so that link scanners cannot unsubscribe anyone; POST
+// unsubscribes.
+func (fe *frontendServer) unsubscribeHandler(w http.ResponseWriter, r *http.Request) {
+	payload := validator.UnsubscribePayload{Token: r.FormValue("token")}
+	if err := payload.Validate(); err != nil {
+		renderHTTPError(r, w, validator.ValidationErrorResponse(err), http.StatusUnprocessableEntity)
+		return
+	}
+
+	done := r.Method == http.MethodPost
+	if done {
+		_, err := pb.NewNotificationServiceClient(fe.notificationSvcConn).
+			Unsubscribe(r.Context(), &pb.UnsubscribeRequest{UnsubscribeToken: payload.Token})
+		switch status.Code(err) {
+		case codes.OK, codes.NotFound:
+			// Unsubscribing twice is not worth an error page.
+		default:
+			renderHTTPError(r, w, errors.Wrap(err, "failed to unsubscribe"), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if err := templates.ExecuteTemplate(w, "unsubscribe", injectCommonTemplateData(r, map[string]interface{}{
+		"token": payload.Token,
+		"done":  done,
+	})); err != nil {
+		log.Printf("unsubscribeHandler: Error rendering template: %v", err)
+	}
+}
+
 func (fe *frontendServer) addToCartHandler(w http.ResponseWriter, r *http.Request) {
 
 	quantity, _ := strconv.ParseUint(r.FormValue("quantity"), 10, 32)
 	productID := r.FormValue("product_id")
-	log.Printf("addToCartHandler: Received product_id=%s, quantity=%d", productID, quantity)
+	sku := r.FormValue("sku")
+	log.Printf("addToCartHandler: Received product_id=%s, sku=%s, quantity=%d", productID, sku, quantity)
 
 	// 1. Validate payload
 	payload := validator.AddToCartPayload{
 		Quantity:  quantity,
 		ProductID: productID,
+		SKU:       sku,
 	}
 	validationErr := payload.Validate()
 
@@ -386,8 +812,17 @@ func (fe *frontendServer) addToCartHandler(w http.ResponseWriter, r *http.Reques
 	}
 	log.Printf("addToCartHandler: Retrieved product details for product_id=%s", productID)
 
+	if _, err := findVariant(p, payload.SKU); err != nil {
+		renderHTTPError(r, w, err, http.StatusUnprocessableEntity)
+		return
+	}
+	if p.GetOutOfStock() {
+		renderHTTPError(r, w, errors.Errorf("%s is out of stock", p.GetName()), http.StatusUnprocessableEntity)
+		return
+	}
+
 	// 3. Add product to cart
-	cartErr := fe.insertCart(r.Context(), sessionID(r), p.GetId(), int32(payload.Quantity))
+	cartErr := fe.insertCart(r.Context(), sessionID(r), p.GetId(), payload.SKU, int32(payload.Quantity))
 
 	if cartErr != nil {
 		log.Printf("addToCartHandler: Error adding product_id=%s to cart: %v", productID, cartErr)
@@ -403,6 +838,581 @@ func (fe *frontendServer) addToCartHandler(w http.ResponseWriter, r *http.Reques
 	log.Println("addToCartHandler: Redirected to /cart")
 }
 
+// cartItemView is a cart item as shown on the cart page.
+type cartItemView struct {
+	Item     *pb.Product
+	Sku      string
+	Quantity int32
+	Price    *pb.Money
+}
+
+// shippingOptionView is a shipping option priced in the user's currency.
+type shippingOptionView struct {
+	ServiceLevel          string
+	Name                  string
+	Cost                  *pb.Money
+	EstimatedDeliveryDate string
+}
+
+// quoteAddress is where the cart page quotes shipping to before the user has
+// entered an address. It is the checkout form's default address.
+var quoteAddress = &pb.Address{
+	StreetAddress: "1600 Amphitheatre Parkway",
+	City:          "Mountain View",
+	State:         "CA",
+	PostalCode:    "94043",
+	Country:       "United States",
+}
+
+// viewCartHandler shows the cart with its total, every shipping option for
+// it and the checkout form
+func (fe *frontendServer) viewCartHandler(w http.ResponseWriter, r *http.Request) {
+	userId := sessionID(r)
+	log.Printf("viewCartHandler: Received request for user_id=%s", userId)
+
+	currencies, err := fe.getCurrencies(r.Context(), userId)
+	if err != nil {
+		renderHTTPError(r, w, errors.Wrap(err, "could not retrieve currencies"), http.StatusInternalServerError)
+		return
+	}
+	cart, err := fe.getCart(r.Context(), userId)
+	if err != nil {
+		renderHTTPError(r, w, errors.Wrap(err, "could not retrieve cart"), http.StatusInternalServerError)
+		return
+	}
+
+	// 1. Price the items in the user's currency
+	currency := currentCurrency(r)
+	subtotal := &pb.Money{CurrencyCode: currency}
+	items := make([]cartItemView, len(cart))
+	productIDs := make([]string, len(cart))
+	for i, it := range cart {
+		p, err := fe.getProduct(r.Context(), it.GetProductId())
+		if err != nil {
+			renderHTTPError(r, w, errors.Wrapf(err, "could not retrieve product %s", it.GetProductId()), http.StatusInternalServerError)
+			return
+		}
+		v, err := findVariant(p, it.GetSku())
+		if err != nil {
+			renderHTTPError(r, w, err, http.StatusInternalServerError)
+			return
+		}
+		price, err := fe.localPrice(r.Context(), p, v, currency, userId)
+		if err != nil {
+			renderHTTPError(r, w, errors.Wrapf(err, "failed to do currency conversion for product %s", p.GetId()), http.StatusInternalServerError)
+			return
+		}
+		cost := MultiplySlow(price, uint32(it.GetQuantity()))
+		subtotal = Must(Sum(subtotal, cost))
+		items[i] = cartItemView{Item: p, Sku: it.GetSku(), Quantity: it.GetQuantity(), Price: cost}
+		productIDs[i] = p.GetId()
+	}
+
+	// 2. Quote every service level. The form preselects the first, and
+	// cheapest, option, so the total includes its cost.
+	var options []shippingOptionView
+	shippingCost := &pb.Money{CurrencyCode: currency}
+	if len(cart) > 0 {
+		quote, err := pb.NewShippingServiceClient(fe.shippingSvcConn).GetQuote(r.Context(), &pb.GetQuoteRequest{
+			Address: quoteAddress,
+			Items:   cart,
+		})
+		if err != nil {
+			renderHTTPError(r, w, errors.Wrap(err, "failed to get shipping quote"), http.StatusInternalServerError)
+			return
+		}
+		for _, o := range quote.GetOptions() {
+			cost, err := fe.convertCurrency(r.Context(), o.GetCostUsd(), currency, userId)
+			if err != nil {
+				renderHTTPError(r, w, errors.Wrap(err, "failed to convert shipping cost to currency"), http.StatusInternalServerError)
+				return
+			}
+			options = append(options, shippingOptionView{
+				ServiceLevel:          o.GetServiceLevel(),
+				Name:                  o.GetName(),
+				Cost:                  cost,
+				EstimatedDeliveryDate: o.GetEstimatedDeliveryDate(),
+			})
+		}
+		if len(options) > 0 {
+			shippingCost = options[0].Cost
+		}
+	}
+
+	// 3. Get recommendations to go with the cart; they are not critical
+	recommendations, err := fe.getRecommendations(r.Context(), &pb.ListRecommendationsRequest{
+		UserId:         userId,
+		ProductIds:     productIDs,
+		Context:        pb.RecommendationContext_RECOMMENDATION_CONTEXT_CART,
+		MaxPerCategory: 2,
+	})
+	if err != nil {
+		log.Printf("viewCartHandler: Error retrieving recommendations: %v", err)
+	}
+
+	year := time.Now().Year()
+	years := []int{year, year + 1, year + 2, year + 3, year + 4}
+
+	if err := templates.ExecuteTemplate(w, "cart", injectCommonTemplateData(r, map[string]interface{}{
+		"show_currency":    true,
+		"currencies":       currencies,
+		"items":            items,
+		"cart_size":        cartSize(cart),
+		"shipping_options": options,
+		"shipping_cost":    shippingCost,
+		"total_cost":       Must(Sum(subtotal, shippingCost)),
+		"expiration_years": years,
+		"recommendations":  recommendations,
+	})); err != nil {
+		log.Printf("viewCartHandler: Error rendering template: %v", err)
+	}
+}
+
+// emptyCartHandler empties the cart and shows it
+func (fe *frontendServer) emptyCartHandler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("emptyCartHandler: Received request for user_id=%s", sessionID(r))
+
+	if _, err := pb.NewCartServiceClient(fe.cartSvcConn).EmptyCart(r.Context(), &pb.EmptyCartRequest{UserId: sessionID(r)}); err != nil {
+		renderHTTPError(r, w, errors.Wrap(err, "failed to empty cart"), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("location", "/cart")
+	w.WriteHeader(http.StatusFound)
+}
+
+// wishlistItemView is a wishlist item as shown on the wishlist page.
+type wishlistItemView struct {
+	Item    *pb.Product
+	Sku     string
+	Label   string
+	Picture string
+	Price   *pb.Money
+}
+
+// wishlistHandler shows the user's wishlist, newest first
+func (fe *frontendServer) wishlistHandler(w http.ResponseWriter, r *http.Request) {
+	log.Printf("wishlistHandler: Received request for user_id=%s", sessionID(r))
+
+	wishlist, err := pb.NewWishlistServiceClient(fe.wishlistSvcConn).
+		ListItems(r.Context(), &pb.ListWishlistRequest{UserId: sessionID(r)})
+	if err != nil {
+		renderHTTPError(r, w, errors.Wrap(err, "could not retrieve wishlist"), http.StatusInternalServerError)
+		return
+	}
+	currencies, err := fe.getCurrencies(r.Context(), sessionID(r))
+	if err != nil {
+		renderHTTPError(r, w, errors.Wrap(err, "could not retrieve currencies"), http.StatusInternalServerError)
+		return
+	}
+	cart, err := fe.getCart(r.Context(), sessionID(r))
+	if err != nil {
+		renderHTTPError(r, w, errors.Wrap(err, "could not retrieve cart"), http.StatusInternalServerError)
+		return
+	}
+
+	items := make([]wishlistItemView, 0, len(wishlist.GetItems()))
+	for _, it := range wishlist.GetItems() {
+		p, err := fe.getProduct(r.Context(), it.GetProductId())
+		if status.Code(err) == codes.NotFound {
+			// The product has left the catalog since it was saved.
+			continue
+		} else if err != nil {
+			renderHTTPError(r, w, errors.Wrapf(err, "could not retrieve product %s", it.GetProductId()), http.StatusInternalServerError)
+			return
+		}
+		v, err := findVariant(p, it.GetSku())
+		if err != nil {
+			log.Printf("wishlistHandler: Skipping item: %v", err)
+			continue
+		}
+		price, err := fe.localPrice(r.Context(), p, v, currentCurrency(r), sessionID(r))
+		if err != nil {
+			renderHTTPError(r, w, errors.Wrapf(err, "failed to do currency conversion for product %s", p.GetId()), http.StatusInternalServerError)
+			return
+		}
+		items = append(items, wishlistItemView{
+			Item:    p,
+			Sku:     it.GetSku(),
+			Label:   variantLabel(v),
+			Picture: variantPicture(p, v),
+			Price:   price,
+		})
+	}
+
+	if err := templates.ExecuteTemplate(w, "wishlist", injectCommonTemplateData(r, map[string]interface{}{
+		"show_currency": true,
+		"currencies":    currencies,
+		"items":         items,
+		"cart_size":     cartSize(cart),
+	})); err != nil {
+		log.Printf("wishlistHandler: Error rendering template: %v", err)
+	}
+}
+
+// wishlistPayload reads and validates the wishlist item named by a form.
+func wishlistPayload(r *http.Request) (validator.WishlistItemPayload, error) {
+	payload := validator.WishlistItemPayload{
+		ProductID: r.FormValue("product_id"),
+		SKU:       r.FormValue("sku"),
+	}
+	if err := payload.Validate(); err != nil {
+		return payload, validator.ValidationErrorResponse(err)
+	}
+	return payload, nil
+}
+
+// addToWishlistHandler saves a product for later and shows the wishlist
+func (fe *frontendServer) addToWishlistHandler(w http.ResponseWriter, r *http.Request) {
+	payload, err := wishlistPayload(r)
+	if err != nil {
+		renderHTTPError(r, w, err, http.StatusUnprocessableEntity)
+		return
+	}
+	log.Printf("addToWishlistHandler: Received product_id=%s, sku=%s", payload.ProductID, payload.SKU)
+
+	_, err = pb.NewWishlistServiceClient(fe.wishlistSvcConn).AddItem(r.Context(), &pb.AddWishlistItemRequest{
+		UserId:    sessionID(r),
+		ProductId: payload.ProductID,
+		Sku:       payload.SKU,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument, codes.ResourceExhausted:
+		renderHTTPError(r, w, errors.Wrap(err, "could not add to wishlist"), http.StatusUnprocessableEntity)
+		return
+	case codes.NotFound:
+		renderHTTPError(r, w, errors.Errorf("product %s not found", payload.ProductID), http.StatusNotFound)
+		return
+	default:
+		renderHTTPError(r, w, errors.Wrap(err, "failed to add to wishlist"), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("location", "/wishlist")
+	w.WriteHeader(http.StatusFound)
+}
+
+// removeFromWishlistHandler removes an item from the wishlist and shows what
+// is left
+func (fe *frontendServer) removeFromWishlistHandler(w http.ResponseWriter, r *http.Request) {
+	payload, err := wishlistPayload(r)
+	if err != nil {
+		renderHTTPError(r, w, err, http.StatusUnprocessableEntity)
+		return
+	}
+	log.Printf("removeFromWishlistHandler: Received product_id=%s, sku=%s", payload.ProductID, payload.SKU)
+
+	if _, err := pb.NewWishlistServiceClient(fe.wishlistSvcConn).RemoveItem(r.Context(), &pb.RemoveWishlistItemRequest{
+		UserId:    sessionID(r),
+		ProductId: payload.ProductID,
+		Sku:       payload.SKU,
+	}); err != nil {
+		renderHTTPError(r, w, errors.Wrap(err, "failed to remove from wishlist"), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("location", "/wishlist")
+	w.WriteHeader(http.StatusFound)
+}
+
+// moveToCartHandler moves one unit of a wishlist item to the cart and shows
+// what is left of the wishlist
+func (fe *frontendServer) moveToCartHandler(w http.ResponseWriter, r *http.Request) {
+	payload, err := wishlistPayload(r)
+	if err != nil {
+		renderHTTPError(r, w, err, http.StatusUnprocessableEntity)
+		return
+	}
+	log.Printf("moveToCartHandler: Received product_id=%s, sku=%s", payload.ProductID, payload.SKU)
+
+	_, err = pb.NewWishlistServiceClient(fe.wishlistSvcConn).MoveToCart(r.Context(), &pb.MoveToCartRequest{
+		UserId:    sessionID(r),
+		ProductId: payload.ProductID,
+		Sku:       payload.SKU,
+		Quantity:  1,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		renderHTTPError(r, w, errors.Errorf("product %s is not on your wishlist", payload.ProductID), http.StatusNotFound)
+		return
+	default:
+		renderHTTPError(r, w, errors.Wrap(err, "failed to move to cart"), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("location", "/wishlist")
+	w.WriteHeader(http.StatusFound)
+}
+
+// trackShipmentHandler shows the status and history of a shipment
+func (fe *frontendServer) trackShipmentHandler(w http.ResponseWriter, r *http.Request) {
+	trackingID := strings.TrimSpace(r.FormValue("tracking_id"))
+	log.Printf("trackShipmentHandler: Received tracking_id=%s", trackingID)
+
+	if trackingID == "" {
+		renderHTTPError(r, w, errors.New("tracking_id is required"), http.StatusBadRequest)
+		return
+	}
+
+	shipment, err := pb.NewShippingServiceClient(fe.shippingSvcConn).
+		TrackShipment(r.Context(), &pb.TrackShipmentRequest{TrackingId: trackingID})
+	if status.Code(err) == codes.NotFound {
+		renderHTTPError(r, w, errors.Wrap(err, "unknown tracking number"), http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Printf("trackShipmentHandler: Error tracking shipment %s: %v", trackingID, err)
+		renderHTTPError(r, w, errors.Wrap(err, "could not track shipment"), http.StatusInternalServerError)
+		return
+	}
+	log.Printf("trackShipmentHandler: Shipment %s is %s", trackingID, shipment.GetStatus())
+
+	currencies, err := fe.getCurrencies(r.Context(), sessionID(r))
+	if err != nil {
+		renderHTTPError(r, w, errors.Wrap(err, "could not retrieve currencies"), http.StatusInternalServerError)
+		return
+	}
+
+	if err := templates.ExecuteTemplate(w, "tracking", injectCommonTemplateData(r, map[string]interface{}{
+		"show_currency": false,
+		"currencies":    currencies,
+		"shipment":      shipment,
+	})); err != nil {
+		log.Printf("trackShipmentHandler: Error rendering template: %v", err)
+	}
+}
+
+// searchPageSize is how many results fit one search page.
+const searchPageSize = 12
+
+// searchHandler shows products matching a query, with category and price
+// filters
+func (fe *frontendServer) searchHandler(w http.ResponseWriter, r *http.Request) {
+	query := strings.TrimSpace(r.FormValue("q"))
+	categories := r.Form["category"]
+	minPrice, maxPrice := r.FormValue("min_price"), r.FormValue("max_price")
+	log.Printf("searchHandler: Received q=%q categories=%v price=[%s,%s)", query, categories, minPrice, maxPrice)
+
+	req := &pb.SearchProductsRequest{
+		Query:      query,
+		Categories: categories,
+		PageSize:   searchPageSize,
+		PageToken:  r.FormValue("page"),
+	}
+	for _, bound := range []struct {
+		value string
+		dst   **pb.Money
+	}{{minPrice, &req.MinPriceUsd}, {maxPrice, &req.MaxPriceUsd}} {
+		if bound.value == "" {
+			continue
+		}
+		units, err := strconv.ParseInt(bound.value, 10, 64)
+		if err != nil || units < 0 {
+			renderHTTPError(r, w, errors.Errorf("invalid price %q", bound.value), http.StatusBadRequest)
+			return
+		}
+		*bound.dst = &pb.Money{CurrencyCode: "USD", Units: units}
+	}
+
+	resp, err := pb.NewProductCatalogServiceClient(fe.productCatalogSvcConn).SearchProducts(r.Context(), req)
+	if status.Code(err) == codes.InvalidArgument {
+		renderHTTPError(r, w, errors.Wrap(err, "invalid search"), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		renderHTTPError(r, w, errors.Wrap(err, "could not search products"), http.StatusInternalServerError)
+		return
+	}
+
+	currencies, err := fe.getCurrencies(r.Context(), sessionID(r))
+	if err != nil {
+		renderHTTPError(r, w, errors.Wrap(err, "could not retrieve currencies"), http.StatusInternalServerError)
+		return
+	}
+	cart, err := fe.getCart(r.Context(), sessionID(r))
+	if err != nil {
+		renderHTTPError(r, w, errors.Wrap(err, "could not retrieve cart"), http.StatusInternalServerError)
+		return
+	}
+
+	type productView struct {
+		Item  *pb.Product
+		Price *pb.Money
+	}
+	ps := make([]productView, len(resp.GetResults()))
+	for i, p := range resp.GetResults() {
+		price, err := fe.localPrice(r.Context(), p, nil, currentCurrency(r), sessionID(r))
+		if err != nil {
+			renderHTTPError(r, w, errors.Wrapf(err, "failed to do currency conversion for product %s", p.GetId()), http.StatusInternalServerError)
+			return
+		}
+		ps[i] = productView{p, price}
+	}
+
+	// Facets link to the same search with one filter changed.
+	type facetView struct {
+		Label    string
+		Count    int32
+		URL      string
+		Selected bool
+	}
+	selected := map[string]bool{}
+	for _, c := range categories {
+		selected[c] = true
+	}
+	var categoryFacets []facetView
+	for _, f := range resp.GetCategoryFacets() {
+		cats := []string{f.GetCategory()}
+		if selected[f.GetCategory()] {
+			cats = nil
+		}
+		categoryFacets = append(categoryFacets, facetView{
+			Label:    f.GetCategory(),
+			Count:    f.GetCount(),
+			URL:      searchURL(query, cats, minPrice, maxPrice, ""),
+			Selected: selected[f.GetCategory()],
+		})
+	}
+	var priceFacets []facetView
+	for _, f := range resp.GetPriceFacets() {
+		lo, hi := strconv.FormatInt(f.GetMinUsd().GetUnits(), 10), ""
+		label := "$" + lo + "+"
+		if f.GetMaxUsd() != nil {
+			hi = strconv.FormatInt(f.GetMaxUsd().GetUnits(), 10)
+			label = "$" + lo + " – $" + hi
+		}
+		isSelected := lo == minPrice && hi == maxPrice
+		if isSelected {
+			lo, hi = "", ""
+		}
+		priceFacets = append(priceFacets, facetView{
+			Label:    label,
+			Count:    f.GetCount(),
+			URL:      searchURL(query, categories, lo, hi, ""),
+			Selected: isSelected,
+		})
+	}
+	var nextPage string
+	if resp.GetNextPageToken() != "" {
+		nextPage = searchURL(query, categories, minPrice, maxPrice, resp.GetNextPageToken())
+	}
+
+	if err := templates.ExecuteTemplate(w, "search", injectCommonTemplateData(r, map[string]interface{}{
+		"show_currency":   true,
+		"currencies":      currencies,
+		"cart_size":       cartSize(cart),
+		"query":           query,
+		"products":        ps,
+		"total_results":   resp.GetTotalResults(),
+		"category_facets": categoryFacets,
+		"price_facets":    priceFacets,
+		"next_page":       nextPage,
+	})); err != nil {
+		log.Printf("searchHandler: Error rendering template: %v", err)
+	}
+}
+
+// searchURL returns the URL of a search page.
+func searchURL(query string, categories []string, minPrice, maxPrice, page string) string {
+	v := url.Values{}
+	if query != "" {
+		v.Set("q", query)
+	}
+	for _, c := range categories {
+		v.Add("category", c)
+	}
+	if minPrice != "" {
+		v.Set("min_price", minPrice)
+	}
+	if maxPrice != "" {
+		v.Set("max_price", maxPrice)
+	}
+	if page != "" {
+		v.Set("page", page)
+	}
+	return "/search?" + v.Encode()
+}
+
+// adClickHandler records a click on an ad and redirects to its target
+func (fe *frontendServer) adClickHandler(w http.ResponseWriter, r *http.Request) {
+	adID := r.PathValue("id")
+	resp, err := pb.NewAdServiceClient(fe.adSvcConn).RecordClick(r.Context(), &pb.RecordClickRequest{
+		UserId: sessionID(r),
+		AdId:   adID,
+	})
+	if status.Code(err) == codes.NotFound {
+		renderHTTPError(r, w, errors.Wrap(err, "unknown ad"), http.StatusNotFound)
+		return
+	} else if err != nil {
+		renderHTTPError(r, w, errors.Wrap(err, "could not record ad click"), http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, resp.GetRedirectUrl(), http.StatusFound)
+}
+
+// adMetricsHandler exposes ad impressions, clicks and click-through rates in
+// the Prometheus text format
+func (fe *frontendServer) adMetricsHandler(w http.ResponseWriter, r *http.Request) {
+	stats, err := pb.NewAdServiceClient(fe.adSvcConn).GetAdStats(r.Context(), &pb.GetAdStatsRequest{})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not retrieve ad stats: %v", err), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	for _, m := range []struct {
+		name, help, typ string
+		value           func(*pb.AdStat) string
+	}{
+		{"ad_impressions_total", "Ad impressions recorded.", "counter", func(s *pb.AdStat) string { return strconv.FormatInt(s.GetImpressions(), 10) }},
+		{"ad_clicks_total", "Ad clicks recorded.", "counter", func(s *pb.AdStat) string { return strconv.FormatInt(s.GetClicks(), 10) }},
+		{"ad_click_through_rate", "Clicks per impression.", "gauge", func(s *pb.AdStat) string { return strconv.FormatFloat(s.GetCtr(), 'g', -1, 64) }},
+	} {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", m.name, m.help, m.name, m.typ)
+		for _, s := range stats.GetAds() {
+			fmt.Fprintf(w, "%s{ad_id=%q} %s\n", m.name, s.GetKey(), m.value(s))
+		}
+		for _, s := range stats.GetCategories() {
+			fmt.Fprintf(w, "%s{category=%q} %s\n", m.name, s.GetKey(), m.value(s))
+		}
+	}
+}
+
+// emailMetricsHandler exposes the email delivery queue's status in the
+// Prometheus text format.
+func (fe *frontendServer) emailMetricsHandler(w http.ResponseWriter, r *http.Request) {
+	st, err := pb.NewEmailServiceClient(fe.emailSvcConn).GetQueueStatus(r.Context(), &pb.GetQueueStatusRequest{})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not retrieve email queue status: %v", err), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	for _, m := range []struct {
+		name, help, typ string
+		value           int64
+	}{
+		{"email_queue_depth", "Emails waiting to be sent or retried.", "gauge", int64(st.GetQueueDepth())},
+		{"email_in_flight", "Emails being sent.", "gauge", int64(st.GetInFlight())},
+		{"email_delivered_total", "Emails delivered.", "counter", st.GetDelivered()},
+		{"email_failed_attempts_total", "Delivery attempts that failed.", "counter", st.GetFailedAttempts()},
+		{"email_dead_letters", "Emails given up on and kept as dead letters.", "gauge", int64(len(st.GetDeadLetters()))},
+	} {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %d\n", m.name, m.help, m.name, m.typ, m.name, m.value)
+	}
+	fmt.Fprintf(w, "# HELP email_delivery_latency_ms Time from acceptance to delivery of recent emails.\n# TYPE email_delivery_latency_ms summary\n")
+	for _, q := range []struct {
+		quantile string
+		value    float64
+	}{
+		{"0.5", st.GetDeliveryLatencyP50Ms()},
+		{"0.95", st.GetDeliveryLatencyP95Ms()},
+		{"1", st.GetDeliveryLatencyMaxMs()},
+	} {
+		fmt.Fprintf(w, "email_delivery_latency_ms{quantile=%q} %s\n", q.quantile, strconv.FormatFloat(q.value, 'g', -1, 64))
+	}
+}
+
 func (fe *frontendServer) getCurrencies(ctx context.Context, userID string) ([]string, error) {
 	currs, err := pb.NewCurrencyServiceClient(fe.currencySvcConn).
 		GetSupportedCurrencies(ctx, &pb.EmptyUser{UserId: userID})
@@ -420,16 +1430,8 @@ func (fe *frontendServer) getCurrencies(ctx context.Context, userID string) ([]s
 	return out, nil
 }
 
-func (fe *frontendServer) getProducts(ctx context.Context, userID string) ([]*pb.Product, error) {
-	resp, err := pb.NewProductCatalogServiceClient(fe.productCatalogSvcConn).
-		ListProducts(ctx, &pb.EmptyUser{UserId: userID})
-
-	if err != nil {
-		return nil, err
-	}
-
-	products := resp.GetProducts()
-	return products, err
+func (fe *frontendServer) getProductsPage(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	return pb.NewProductCatalogServiceClient(fe.productCatalogSvcConn).ListProductsPage(ctx, req)
 }
 
 func (fe *frontendServer) getProduct(ctx context.Context, id string) (*pb.Product, error) {
@@ -454,11 +1456,12 @@ func (fe *frontendServer) getCart(ctx context.Context, userID string) ([]*pb.Car
 	return items, err
 }
 
-func (fe *frontendServer) insertCart(ctx context.Context, userID, productID string, quantity int32) error {
+func (fe *frontendServer) insertCart(ctx context.Context, userID, productID, sku string, quantity int32) error {
 	_, err := pb.NewCartServiceClient(fe.cartSvcConn).AddItem(ctx, &pb.AddItemRequest{
 		UserId: userID,
 		Item: &pb.CartItem{
 			ProductId: productID,
+			Sku:       sku,
 			Quantity:  quantity},
 	})
 	return err
@@ -482,26 +1485,62 @@ func (fe *frontendServer) convertCurrency(ctx context.Context, money *pb.Money,
 	return result, err
 }
 
-func (fe *frontendServer) getRecommendations(ctx context.Context, userID string, productIDs []string) ([]*pb.Product, error) {
-	resp, err := pb.NewRecommendationServiceClient(fe.recommendationSvcConn).ListRecommendations(ctx,
-		&pb.ListRecommendationsRequest{UserId: userID, ProductIds: productIDs})
+// getReviews returns the rating summary of a product and the page of its
+// reviews starting at pageToken.
+func (fe *frontendServer) getReviews(ctx context.Context, productID, pageToken string) (*pb.RatingSummary, *pb.ListReviewsResponse, error) {
+	cl := pb.NewReviewServiceClient(fe.reviewSvcConn)
+	summary, err := cl.GetRatingSummary(ctx, &pb.GetRatingSummaryRequest{ProductIds: []string{productID}})
+	if err != nil {
+		return nil, nil, err
+	}
+	reviews, err := cl.ListReviews(ctx, &pb.ListReviewsRequest{
+		ProductId: productID,
+		PageSize:  reviewsPageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return summary.GetSummaries()[0], reviews, nil
+}
+
+// localPrice returns the price of v, a variant of p that may be nil, in
+// currency: the catalog's list price if it has one, else the USD price
+// converted.
+func (fe *frontendServer) localPrice(ctx context.Context, p *pb.Product, v *pb.ProductVariant, currency string, userID string) (*pb.Money, error) {
+	if price, ok := listPrice(p, v, currency); ok {
+		return price, nil
+	}
+	return fe.convertCurrency(ctx, variantPrice(p, v), currency, userID)
+}
+
+// maxRecommendations is how many recommendations fit the UI.
+const maxRecommendations = 4
 
+// recommendedProduct is a recommended product and why it was picked.
+type recommendedProduct struct {
+	*pb.Product
+	Reason string
+}
+
+func (fe *frontendServer) getRecommendations(ctx context.Context, req *pb.ListRecommendationsRequest) ([]recommendedProduct, error) {
+	if req.MaxResults == 0 {
+		req.MaxResults = maxRecommendations
+	}
+	resp, err := pb.NewRecommendationServiceClient(fe.recommendationSvcConn).ListRecommendations(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	out := make([]*pb.Product, len(resp.GetProductIds()))
-	for i, v := range resp.GetProductIds() {
-		p, err := fe.getProduct(ctx, v)
+	out := make([]recommendedProduct, len(resp.GetRecommendations()))
+	for i, rec := range resp.GetRecommendations() {
+		p, err := fe.getProduct(ctx, rec.GetProductId())
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get recommended product info (#%s)", v)
+			return nil, errors.Wrapf(err, "failed to get recommended product info (#%s)", rec.GetProductId())
 		}
-		out[i] = p
+		out[i] = recommendedProduct{Product: p, Reason: rec.GetReason()}
 	}
-	if len(out) > 4 {
-		out = out[:4] // take only first four to fit the UI
-	}
-	return out, err
+	return out, nil
 }
 
 func (fe *frontendServer) getAd(ctx context.Context, ctxKeys []string, userID string) ([]*pb.Ad, error) {
@@ -560,6 +1599,16 @@ func renderMoney(money *pb.Money) string {
 	return fmt.Sprintf("%s%d.%02d", currencyLogo, money.GetUnits(), money.GetNanos()/10000000)
 }
 
+func renderTimestamp(unix int64) string {
+	return time.Unix(unix, 0).UTC().Format("Jan 2, 2006 15:04 MST")
+}
+
+// renderStars renders a rating of 0 to 5 whole stars.
+func renderStars(stars int32) string {
+	n := int(min(max(stars, 0), 5))
+	return strings.Repeat("★", n) + strings.Repeat("☆", 5-n)
+}
+
 func renderCurrencyLogo(currencyCode string) string {
 	logos := map[string]string{
 		"USD": "$",
@@ -606,15 +1655,27 @@ func cartSize(c []*pb.CartItem) int {
 	return cartSize
 }
 
-// chooseAd queries for advertisements available and randomly chooses one, if
-// available. It ignores the error retrieving the ad since it is not critical.
+// chooseAd queries for advertisements available and returns the first, if
+// any, recording an impression of it. AdService already picks ads at random
+// by weight. It ignores errors since ads are not critical.
 func (fe *frontendServer) chooseAd(ctx context.Context, ctxKeys []string, userId string) *pb.Ad {
 	ads, err := fe.getAd(ctx, ctxKeys, userId)
 	if err != nil {
 		log.Printf("chooseAd: failed to retrieve ads: %v", err)
 		return nil
 	}
+	if len(ads) == 0 {
+		return nil
+	}
 
-	// Choose a random ad from the retrieved ads
-	return ads[rand.Intn(len(ads))]
+	ad := ads[0]
+	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*100)
+	defer cancel()
+	if _, err := pb.NewAdServiceClient(fe.adSvcConn).RecordImpressions(ctx, &pb.RecordImpressionsRequest{
+		UserId: userId,
+		AdIds:  []string{ad.GetId()},
+	}); err != nil {
+		log.Printf("chooseAd: failed to record impression: %v", err)
+	}
+	return ad
 }
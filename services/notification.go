@@ -0,0 +1,421 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net"
+	"net/mail"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+	"github.com/deskchen/online-boutique-grpc/services/redact"
+	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	defaultStorefrontURL = "http://localhost:8080"
+	maxSubscriberEmail   = 254
+)
+
+// NewNotificationService returns a new server for the NotificationService
+func NewNotificationService(port int) *NotificationService {
+	// Subscriptions are kept in memory unless NOTIFICATION_SUBSCRIPTIONS_FILE
+	// names a file to persist them to.
+	var store subscriptionStore = memorySubscriptionStore{}
+	if path := os.Getenv("NOTIFICATION_SUBSCRIPTIONS_FILE"); path != "" {
+		store = newFileSubscriptionStore(path)
+	}
+	subs, err := store.Load()
+	if err != nil {
+		log.Fatalf("Failed to load subscriptions: %v", err)
+	}
+
+	storefront := os.Getenv("STOREFRONT_URL")
+	if storefront == "" {
+		storefront = defaultStorefrontURL
+	}
+
+	s := &NotificationService{
+		port:          port,
+		store:         store,
+		now:           time.Now,
+		pollInterval:  envDuration("NOTIFICATION_POLL_INTERVAL", time.Minute),
+		dedupWindow:   envDuration("NOTIFICATION_DEDUP_WINDOW", 24*time.Hour),
+		storefrontURL: strings.TrimSuffix(storefront, "/"),
+		subs:          make(map[string]*pb.Subscription, len(subs)),
+	}
+	for _, sub := range subs {
+		s.subs[sub.GetUnsubscribeToken()] = sub
+	}
+	log.Printf("Loaded %d subscriptions", len(subs))
+	return s
+}
+
+// NotificationService implements the NotificationService. It polls the
+// catalog and emails subscribers when a product's price_usd drops or when it
+// can be ordered again.
+type NotificationService struct {
+	port int
+	pb.NotificationServiceServer
+
+	productCatalogSvcAddr string
+	productCatalogSvcConn *grpc.ClientConn
+
+	emailSvcAddr string
+	emailSvcConn *grpc.ClientConn
+
+	store         subscriptionStore
+	now           func() time.Time
+	pollInterval  time.Duration
+	dedupWindow   time.Duration
+	storefrontURL string
+
+	mu   sync.Mutex
+	subs map[string]*pb.Subscription // by unsubscribe token
+
+	// seen is the catalog as of the last poll, nil before the first one,
+	// plus every product removed since as unavailable. Only poll touches
+	// it.
+	seen map[string]productState
+}
+
+// productState is what alerts are sent about.
+type productState struct {
+	price     *pb.Money
+	available bool
+}
+
+// Run starts the server
+func (s *NotificationService) Run() error {
+	ctx := context.Background()
+
+	mustMapEnv(&s.productCatalogSvcAddr, "PRODUCT_CATALOG_SERVICE_ADDR")
+	mustMapEnv(&s.emailSvcAddr, "EMAIL_SERVICE_ADDR")
+	mustConnGRPC(ctx, &s.productCatalogSvcConn, s.productCatalogSvcAddr)
+	mustConnGRPC(ctx, &s.emailSvcConn, s.emailSvcAddr)
+
+	go s.watchLoop(ctx)
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer()),
+			redact.UnaryServerInterceptor(),
+		),
+	}
+	srv := grpc.NewServer(opts...)
+	pb.RegisterNotificationServiceServer(srv, s)
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	log.Printf("NotificationService running at port: %d", s.port)
+	return srv.Serve(lis)
+}
+
+// Subscribe subscribes an email address to alerts about a product in the
+// catalog
+func (s *NotificationService) Subscribe(ctx context.Context, req *pb.SubscribeRequest) (*pb.Subscription, error) {
+	log.Printf("Subscribe request for email = %v, product_id = %v, kinds = %v", redact.Email(req.GetEmail()), req.GetProductId(), req.GetKinds())
+
+	email := strings.TrimSpace(req.GetEmail())
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email || len(email) > maxSubscriberEmail {
+		return nil, status.Errorf(codes.InvalidArgument, "email must be a plain email address")
+	}
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	kinds, err := alertKinds(req.GetKinds())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := pb.NewProductCatalogServiceClient(s.productCatalogSvcConn).
+		GetProduct(ctx, &pb.GetProductRequest{Id: req.GetProductId()}); status.Code(err) == codes.NotFound {
+		return nil, status.Errorf(codes.NotFound, "no product with ID %s", req.GetProductId())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to look up product %s: %v", req.GetProductId(), err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var sub *pb.Subscription
+	for _, existing := range s.subs {
+		if strings.EqualFold(existing.GetEmail(), email) && existing.GetProductId() == req.GetProductId() {
+			sub = proto.Clone(existing).(*pb.Subscription)
+			kinds, _ = alertKinds(append(sub.Kinds, kinds...))
+			break
+		}
+	}
+	if sub == nil {
+		token := make([]byte, 16)
+		if _, err := rand.Read(token); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create unsubscribe token")
+		}
+		sub = &pb.Subscription{
+			Id:               uuid.NewString(),
+			Email:            email,
+			ProductId:        req.GetProductId(),
+			UnsubscribeToken: hex.EncodeToString(token),
+			CreatedUnix:      s.now().Unix(),
+		}
+	}
+	sub.Kinds = kinds
+	if err := s.replace(sub.GetUnsubscribeToken(), sub); err != nil {
+		return nil, err
+	}
+	return proto.Clone(sub).(*pb.Subscription), nil
+}
+
+// Unsubscribe cancels the subscription with the given unsubscribe token
+func (s *NotificationService) Unsubscribe(ctx context.Context, req *pb.UnsubscribeRequest) (*pb.Empty, error) {
+	log.Printf("Unsubscribe request received")
+
+	if req.GetUnsubscribeToken() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "unsubscribe_token is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.subs[req.GetUnsubscribeToken()] == nil {
+		return nil, status.Errorf(codes.NotFound, "no subscription with that unsubscribe token")
+	}
+	if err := s.replace(req.GetUnsubscribeToken(), nil); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+// alertKinds sorts and de-duplicates kinds, defaulting to every kind.
+func alertKinds(kinds []pb.ProductAlertKind) ([]pb.ProductAlertKind, error) {
+	if len(kinds) == 0 {
+		return []pb.ProductAlertKind{
+			pb.ProductAlertKind_PRODUCT_ALERT_KIND_PRICE_DROP,
+			pb.ProductAlertKind_PRODUCT_ALERT_KIND_BACK_IN_STOCK,
+		}, nil
+	}
+	var out []pb.ProductAlertKind
+	for _, k := range kinds {
+		switch k {
+		case pb.ProductAlertKind_PRODUCT_ALERT_KIND_PRICE_DROP, pb.ProductAlertKind_PRODUCT_ALERT_KIND_BACK_IN_STOCK:
+		default:
+			return nil, fmt.Errorf("unknown alert kind %v", k)
+		}
+		if !hasAlertKind(out, k) {
+			out = append(out, k)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out, nil
+}
+
+func hasAlertKind(kinds []pb.ProductAlertKind, k pb.ProductAlertKind) bool {
+	for _, kind := range kinds {
+		if kind == k {
+			return true
+		}
+	}
+	return false
+}
+
+// replace sets or, if sub is nil, deletes the subscription with the given
+// token and saves the result, undoing the change if saving fails. s.mu must
+// be held.
+func (s *NotificationService) replace(token string, sub *pb.Subscription) error {
+	prev := s.subs[token]
+	if sub == nil {
+		delete(s.subs, token)
+	} else {
+		s.subs[token] = sub
+	}
+	if err := s.save(); err != nil {
+		if prev == nil {
+			delete(s.subs, token)
+		} else {
+			s.subs[token] = prev
+		}
+		log.Printf("Failed to save subscriptions: %v", err)
+		return status.Errorf(codes.Internal, "failed to save subscription")
+	}
+	return nil
+}
+
+// save persists every subscription, oldest first. s.mu must be held.
+func (s *NotificationService) save() error {
+	subs := make([]*pb.Subscription, 0, len(s.subs))
+	for _, sub := range s.subs {
+		subs = append(subs, sub)
+	}
+	sort.Slice(subs, func(i, j int) bool {
+		if subs[i].GetCreatedUnix() != subs[j].GetCreatedUnix() {
+			return subs[i].GetCreatedUnix() < subs[j].GetCreatedUnix()
+		}
+		return subs[i].GetId() < subs[j].GetId()
+	})
+	return s.store.Save(subs)
+}
+
+func (s *NotificationService) watchLoop(ctx context.Context) {
+	s.poll(ctx)
+	t := time.NewTicker(s.pollInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			s.poll(ctx)
+		}
+	}
+}
+
+// poll compares the catalog with the previous poll and sends alerts for
+// the differences. The first poll only records the catalog. A product is
+// back in stock when it was out of stock or removed from the catalog and is
+// now available; a product that is new to the catalog is not. A product
+// whose alerts could not all be sent keeps its previous state, so the
+// change is seen again next time; the de-duplication window keeps
+// subscribers who did get the alert from getting it twice.
+func (s *NotificationService) poll(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	resp, err := pb.NewProductCatalogServiceClient(s.productCatalogSvcConn).ListProducts(ctx, &pb.EmptyUser{})
+	if err != nil {
+		log.Printf("Failed to poll catalog: %v", err)
+		return
+	}
+
+	current := make(map[string]productState, len(resp.GetProducts()))
+	for _, p := range resp.GetProducts() {
+		current[p.GetId()] = productState{price: p.GetPriceUsd(), available: !p.GetOutOfStock()}
+	}
+	if s.seen == nil {
+		s.seen = current
+		return
+	}
+	// Remember removed products as out of stock, so that restoring one is
+	// an alert and adding a new one is not.
+	for id, old := range s.seen {
+		if _, ok := current[id]; !ok {
+			current[id] = productState{price: old.price, available: false}
+		}
+	}
+
+	for _, p := range resp.GetProducts() {
+		cur := current[p.GetId()]
+		old, known := s.seen[p.GetId()]
+		var err error
+		if known && AreSameCurrency(old.price, cur.price) && compareMoney(cur.price, old.price) < 0 {
+			err = s.alert(ctx, p, pb.ProductAlertKind_PRODUCT_ALERT_KIND_PRICE_DROP, old.price)
+		}
+		if known && !old.available && cur.available {
+			if e := s.alert(ctx, p, pb.ProductAlertKind_PRODUCT_ALERT_KIND_BACK_IN_STOCK, nil); err == nil {
+				err = e
+			}
+		}
+		if err != nil {
+			log.Printf("Failed to send alerts for product %s, retrying next poll: %v", p.GetId(), err)
+			current[p.GetId()] = old
+		}
+	}
+	s.seen = current
+}
+
+// alert emails the subscribers to p who want alerts of the given kind and
+// have not had one within the de-duplication window. It returns the first
+// error from EmailService.
+func (s *NotificationService) alert(ctx context.Context, p *pb.Product, kind pb.ProductAlertKind, oldPrice *pb.Money) error {
+	now := s.now()
+	cutoff := now.Add(-s.dedupWindow).Unix()
+
+	s.mu.Lock()
+	var targets []*pb.Subscription
+	for _, sub := range s.subs {
+		if sub.GetProductId() == p.GetId() && hasAlertKind(sub.GetKinds(), kind) && lastAlert(sub, kind) <= cutoff {
+			targets = append(targets, proto.Clone(sub).(*pb.Subscription))
+		}
+	}
+	s.mu.Unlock()
+	if len(targets) == 0 {
+		return nil
+	}
+
+	// Alerts for the same subscription, kind and window share a key, so
+	// EmailService drops any repeat.
+	window := now.Unix()
+	if w := int64(s.dedupWindow / time.Second); w > 0 {
+		window /= w
+	}
+	productURL := s.storefrontURL + "/product/" + url.PathEscape(p.GetId())
+
+	var firstErr error
+	var sent []string
+	for _, sub := range targets {
+		req := &pb.SendProductAlertRequest{
+			Email:          sub.GetEmail(),
+			IdempotencyKey: fmt.Sprintf("product_alert/%s/%d/%d", sub.GetId(), kind, window),
+			Kind:           kind,
+			ProductId:      p.GetId(),
+			ProductName:    p.GetName(),
+			ProductUrl:     productURL,
+			UnsubscribeUrl: s.storefrontURL + "/unsubscribe?" + url.Values{"token": {sub.GetUnsubscribeToken()}}.Encode(),
+		}
+		if kind == pb.ProductAlertKind_PRODUCT_ALERT_KIND_PRICE_DROP {
+			req.OldPrice = oldPrice
+			req.NewPrice = p.GetPriceUsd()
+		}
+		if _, err := pb.NewEmailServiceClient(s.emailSvcConn).SendProductAlert(ctx, req); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		sent = append(sent, sub.GetUnsubscribeToken())
+	}
+	if len(sent) == 0 {
+		return firstErr
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, token := range sent {
+		sub := s.subs[token]
+		if sub == nil {
+			continue // unsubscribed meanwhile
+		}
+		switch kind {
+		case pb.ProductAlertKind_PRODUCT_ALERT_KIND_PRICE_DROP:
+			sub.LastPriceDropUnix = now.Unix()
+		case pb.ProductAlertKind_PRODUCT_ALERT_KIND_BACK_IN_STOCK:
+			sub.LastBackInStockUnix = now.Unix()
+		}
+	}
+	if err := s.save(); err != nil {
+		log.Printf("Failed to save subscriptions after sending %d alerts: %v", len(sent), err)
+	}
+	log.Printf("Sent %d %v alerts for product %s", len(sent), kind, p.GetId())
+	return firstErr
+}
+
+// lastAlert returns when sub last got an alert of the given kind.
+func lastAlert(sub *pb.Subscription, kind pb.ProductAlertKind) int64 {
+	if kind == pb.ProductAlertKind_PRODUCT_ALERT_KIND_PRICE_DROP {
+		return sub.GetLastPriceDropUnix()
+	}
+	return sub.GetLastBackInStockUnix()
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// subscriptionStore persists subscriptions. Subscriptions change after they
+// are added, as alerts are sent and subscribers leave, so Save is given all
+// of them every time.
+type subscriptionStore interface {
+	Load() ([]*pb.Subscription, error)
+	Save(subs []*pb.Subscription) error
+}
+
+// memorySubscriptionStore keeps nothing beyond the service's own index.
+type memorySubscriptionStore struct{}
+
+func (memorySubscriptionStore) Load() ([]*pb.Subscription, error) { return nil, nil }
+func (memorySubscriptionStore) Save([]*pb.Subscription) error     { return nil }
+
+// fileSubscriptionStore keeps subscriptions in a JSON-lines file, replaced
+// atomically on every Save.
+type fileSubscriptionStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+func newFileSubscriptionStore(path string) *fileSubscriptionStore {
+	return &fileSubscriptionStore{path: path}
+}
+
+func (s *fileSubscriptionStore) Load() ([]*pb.Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var subs []*pb.Subscription
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; sc.Scan(); line++ {
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		sub := &pb.Subscription{}
+		if err := protojson.Unmarshal(sc.Bytes(), sub); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", s.path, line, err)
+		}
+		subs = append(subs, sub)
+	}
+	return subs, sc.Err()
+}
+
+func (s *fileSubscriptionStore) Save(subs []*pb.Subscription) error {
+	var buf bytes.Buffer
+	for _, sub := range subs {
+		line, err := protojson.MarshalOptions{Multiline: false}.Marshal(sub)
+		if err != nil {
+			return err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return writeFileAtomic(s.path, buf.Bytes())
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// productAlertEmail records product alerts as "KIND PRODUCT", failing them
+// while fail is set.
+type productAlertEmail struct {
+	pb.UnimplementedEmailServiceServer
+
+	mu     sync.Mutex
+	fail   bool
+	alerts []string
+}
+
+func (e *productAlertEmail) SendProductAlert(_ context.Context, req *pb.SendProductAlertRequest) (*pb.Empty, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.fail {
+		return nil, status.Error(codes.Unavailable, "email is down")
+	}
+	kind := strings.TrimPrefix(req.GetKind().String(), "PRODUCT_ALERT_KIND_")
+	e.alerts = append(e.alerts, kind+" "+req.GetProductId())
+	return &pb.Empty{}, nil
+}
+
+// take returns and forgets the alerts sent so far.
+func (e *productAlertEmail) take() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	out := strings.Join(e.alerts, ",")
+	e.alerts = nil
+	return out
+}
+
+func TestNotificationAlerts(t *testing.T) {
+	product := func(id string, dollars int64, outOfStock bool) *pb.Product {
+		p := testProduct(id)
+		p.PriceUsd, p.OutOfStock = usd(dollars, 0), outOfStock
+		return p
+	}
+	catalog := &fakeCatalog{products: []*pb.Product{
+		product("MUG", 10, false),
+		product("LAMP", 40, true),
+		product("SOFA", 300, false),
+	}}
+	email := &productAlertEmail{}
+	clock := &testClock{t: time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)}
+	s := &NotificationService{
+		productCatalogSvcConn: dialServer(t, func(srv *grpc.Server) { pb.RegisterProductCatalogServiceServer(srv, catalog) }),
+		emailSvcConn:          dialServer(t, func(srv *grpc.Server) { pb.RegisterEmailServiceServer(srv, email) }),
+		store:                 memorySubscriptionStore{},
+		now:                   clock.now,
+		dedupWindow:           24 * time.Hour,
+		storefrontURL:         defaultStorefrontURL,
+		subs:                  map[string]*pb.Subscription{},
+	}
+	ctx := context.Background()
+	for _, id := range []string{"MUG", "LAMP", "SOFA"} {
+		if _, err := s.Subscribe(ctx, &pb.SubscribeRequest{Email: "someone@example.com", ProductId: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, step := range []struct {
+		name     string
+		change   func()
+		advance  time.Duration
+		emailUp  bool
+		wantSent string
+	}{
+		{"first poll only records", func() {}, 0, true, ""},
+		{"price drop", func() { catalog.products[0] = product("MUG", 8, false) }, time.Minute, true, "PRICE_DROP MUG"},
+		{"second drop in the window", func() { catalog.products[0] = product("MUG", 7, false) }, time.Minute, true, ""},
+		{"price rise", func() { catalog.products[0] = product("MUG", 9, false) }, time.Minute, true, ""},
+		{"back in stock", func() { catalog.products[1] = product("LAMP", 40, false) }, time.Minute, true, "BACK_IN_STOCK LAMP"},
+		// Subscribers to a product that is new to the catalog, as from
+		// ImportProducts, are not told it is back in stock.
+		{"new product", func() {
+			catalog.products = append(catalog.products, product("RUG", 50, false))
+			if _, err := s.Subscribe(ctx, &pb.SubscribeRequest{Email: "someone@example.com", ProductId: "RUG"}); err != nil {
+				t.Fatal(err)
+			}
+		}, time.Minute, true, ""},
+		{"removed", func() { catalog.products = append(catalog.products[:2], catalog.products[3]) }, time.Minute, true, ""},
+		{"restored while email is down", func() { catalog.products = append(catalog.products, product("SOFA", 300, false)) }, time.Minute, false, ""},
+		{"retried once email is back", func() {}, time.Minute, true, "BACK_IN_STOCK SOFA"},
+		{"not repeated", func() {}, time.Minute, true, ""},
+		{"drop after the window", func() { catalog.products[0] = product("MUG", 5, false) }, 24 * time.Hour, true, "PRICE_DROP MUG"},
+	} {
+		step.change()
+		clock.advance(step.advance)
+		email.mu.Lock()
+		email.fail = !step.emailUp
+		email.mu.Unlock()
+		s.poll(ctx)
+		if got := email.take(); got != step.wantSent {
+			t.Errorf("%s: sent %q, want %q", step.name, got, step.wantSent)
+		}
+	}
+}
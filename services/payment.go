@@ -5,27 +5,68 @@ import (
 	"fmt"
 	"log"
 	"net"
-	"strings"
+	"os"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/opentracing/opentracing-go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
 
 	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+	"github.com/deskchen/online-boutique-grpc/services/redact"
 )
 
-type InvalidCreditCardErr struct{}
+// paymentErrorDomain identifies payment errors in google.rpc.ErrorInfo
+// details.
+const paymentErrorDomain = "payment.onlineboutique"
+
+// paymentStatus builds a gRPC status carrying an ErrorInfo with reason, plus
+// a BadRequest field violation when field is set.
+func paymentStatus(code codes.Code, reason, msg, field string) *status.Status {
+	st := status.New(code, msg)
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{Reason: reason, Domain: paymentErrorDomain},
+	}
+	if field != "" {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: msg}},
+		})
+	}
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+type InvalidCreditCardErr struct {
+	// Field is the CreditCardInfo field that failed validation.
+	Field  string
+	Reason string
+}
 
 func (e InvalidCreditCardErr) Error() string {
-	return "invalid credit card"
+	if e.Reason == "" {
+		return "invalid credit card"
+	}
+	return "invalid credit card: " + e.Reason
+}
+
+func (e InvalidCreditCardErr) GRPCStatus() *status.Status {
+	return paymentStatus(codes.InvalidArgument, "CARD_INVALID", e.Error(), e.Field)
 }
 
 type UnacceptedCreditCardErr struct{}
 
 func (e UnacceptedCreditCardErr) Error() string {
-	return "credit card not accepted; only VISA or MasterCard are accepted"
+	return "credit card not accepted; only Visa, MasterCard, American Express or Discover are accepted"
+}
+
+func (e UnacceptedCreditCardErr) GRPCStatus() *status.Status {
+	return paymentStatus(codes.FailedPrecondition, "CARD_NETWORK_NOT_ACCEPTED", e.Error(), "credit_card_number")
 }
 
 type ExpiredCreditCardErr struct{}
@@ -34,47 +75,83 @@ func (e ExpiredCreditCardErr) Error() string {
 	return "credit card expired"
 }
 
-func validateAndCharge(amount *pb.Money, card *pb.CreditCardInfo) (string, error) {
-	// Perform some rudimentary validation.
-	number := strings.ReplaceAll(card.CreditCardNumber, "-", "")
-	var company string
-	switch {
-	case len(number) < 4:
-		return "", InvalidCreditCardErr{}
-	case number[0] == '4':
-		company = "Visa"
-	case number[0] == '5':
-		company = "MasterCard"
-	default:
-		return "", UnacceptedCreditCardErr{}
+func (e ExpiredCreditCardErr) GRPCStatus() *status.Status {
+	return paymentStatus(codes.OutOfRange, "CARD_EXPIRED", e.Error(), "credit_card_expiration_year")
+}
+
+// validateAndCharge validates the card, then authorizes amount and, if
+// capture is set, captures it in the same gateway call. The resulting
+// transaction is recorded in the ledger.
+func (s *PaymentService) validateAndCharge(ctx context.Context, amount *pb.Money, card *pb.CreditCardInfo, capture bool) (string, error) {
+	network, err := validateCard(card, time.Now())
+	if err != nil {
+		return "", err
+	}
+	if !IsValid(amount) || !IsPositive(amount) {
+		return "", paymentStatus(codes.InvalidArgument, "INVALID_AMOUNT", "amount must be positive", "amount").Err()
 	}
 
-	if card.CreditCardCvv < 100 || card.CreditCardCvv > 9999 {
-		return "", InvalidCreditCardErr{}
+	// Card is valid: hand the transaction to the processor.
+	var transactionID string
+	if capture {
+		transactionID, err = s.processor.Charge(ctx, amount, card)
+	} else {
+		transactionID, err = s.processor.Authorize(ctx, amount, card)
+	}
+	if err != nil {
+		return "", err
 	}
 
-	if time.Date(int(card.CreditCardExpirationYear), time.Month(card.CreditCardExpirationMonth), 0, 0, 0, 0, 0, time.Local).Before(time.Now()) {
-		return "", ExpiredCreditCardErr{}
+	tx := &pb.Transaction{
+		TransactionId: transactionID,
+		State:         pb.TransactionState_TRANSACTION_STATE_AUTHORIZED,
+		Authorized:    amount,
+		CardNetwork:   network.name,
+		Events:        []*pb.TransactionEvent{s.ledger.event("authorize", amount)},
+	}
+	if capture {
+		tx.State = pb.TransactionState_TRANSACTION_STATE_CAPTURED
+		tx.Captured = amount
+		tx.Events = append(tx.Events, s.ledger.event("capture", amount))
+	}
+	if err := s.ledger.create(tx); err != nil {
+		return "", err
 	}
 
-	// Card is valid: process the transaction.
 	log.Printf(
-		"Transaction processed: company=%s, last_four=%s, currency=%s, amount=%d.%d",
-		company,
-		number[len(number)-4:],
+		"Transaction processed: company=%s, card=%s, currency=%s, amount=%d.%d, captured=%t",
+		network.name,
+		redact.CardNumber(card.GetCreditCardNumber()),
 		amount.CurrencyCode,
 		amount.Units,
 		amount.Nanos,
+		capture,
 	)
-
-	// Generate a transaction ID.
-	return uuid.New().String(), nil
+	return transactionID, nil
 }
 
 // NewPaymentService returns a new server for the PaymentService
 func NewPaymentService(port int) *PaymentService {
+	// Transactions are kept in memory unless PAYMENT_LEDGER_FILE names a
+	// journal to persist them to.
+	var store ledgerStore = memoryLedgerStore{}
+	if path := os.Getenv("PAYMENT_LEDGER_FILE"); path != "" {
+		store = newFileLedgerStore(path)
+	}
+	l, err := newLedger(store)
+	if err != nil {
+		log.Fatalf("Failed to load payment ledger: %v", err)
+	}
+
+	processor, err := newPaymentProcessorFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to set up payment processor: %v", err)
+	}
+
 	return &PaymentService{
-		port: port,
+		port:      port,
+		processor: processor,
+		ledger:    l,
 	}
 }
 
@@ -82,12 +159,18 @@ func NewPaymentService(port int) *PaymentService {
 type PaymentService struct {
 	port int
 	pb.PaymentServiceServer
+
+	processor PaymentProcessor
+	ledger    *ledger
 }
 
 // Run starts the server
 func (s *PaymentService) Run() error {
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer())),
+		grpc.ChainUnaryInterceptor(
+			otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer()),
+			redact.UnaryServerInterceptor(),
+		),
 	}
 	srv := grpc.NewServer(opts...)
 	pb.RegisterPaymentServiceServer(srv, s)
@@ -102,13 +185,9 @@ func (s *PaymentService) Run() error {
 
 // Charge processes a payment charge request
 func (s *PaymentService) Charge(ctx context.Context, req *pb.ChargeRequest) (*pb.ChargeResponse, error) {
-	log.Printf("Charge request received for amount: %v %v", req.GetAmount().GetCurrencyCode(), req.GetAmount().GetUnits())
-	log.Printf("Credit Card Info: Number ending in ****%s, Expiry: %02d/%04d",
-		req.GetCreditCard().GetCreditCardNumber()[len(req.GetCreditCard().GetCreditCardNumber())-4:],
-		req.GetCreditCard().GetCreditCardExpirationMonth(),
-		req.GetCreditCard().GetCreditCardExpirationYear())
+	redact.Logf("Charge request received: %v", req)
 
-	transactionID, err := validateAndCharge(req.GetAmount(), req.GetCreditCard())
+	transactionID, err := s.validateAndCharge(ctx, req.GetAmount(), req.GetCreditCard(), true)
 	if err != nil {
 		log.Printf("Transaction failed: %v", err)
 		return nil, err
@@ -120,3 +199,124 @@ func (s *PaymentService) Charge(ctx context.Context, req *pb.ChargeRequest) (*pb
 		TransactionId: transactionID,
 	}, nil
 }
+
+// Authorize places a hold on the card without capturing funds
+func (s *PaymentService) Authorize(ctx context.Context, req *pb.AuthorizeRequest) (*pb.AuthorizeResponse, error) {
+	redact.Logf("Authorize request received: %v", req)
+
+	transactionID, err := s.validateAndCharge(ctx, req.GetAmount(), req.GetCreditCard(), false)
+	if err != nil {
+		log.Printf("Authorization failed: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Authorization successful: %v", transactionID)
+
+	return &pb.AuthorizeResponse{
+		TransactionId: transactionID,
+	}, nil
+}
+
+// Capture collects some or all of an authorized amount
+func (s *PaymentService) Capture(ctx context.Context, req *pb.CaptureRequest) (*pb.Transaction, error) {
+	log.Printf("Capture request received for transaction: %v", req.GetTransactionId())
+
+	tx, err := s.ledger.update(req.GetTransactionId(), func(tx *pb.Transaction) error {
+		if tx.GetState() != pb.TransactionState_TRANSACTION_STATE_AUTHORIZED {
+			return invalidStateErr("capture", tx)
+		}
+		amount := req.GetAmount()
+		if amount == nil {
+			amount = tx.GetAuthorized()
+		}
+		if err := checkAmount(amount, tx.GetAuthorized(), "authorized amount"); err != nil {
+			return err
+		}
+		if err := s.processor.Capture(ctx, tx.GetTransactionId(), amount); err != nil {
+			return err
+		}
+		tx.State = pb.TransactionState_TRANSACTION_STATE_CAPTURED
+		tx.Captured = amount
+		tx.Events = append(tx.Events, s.ledger.event("capture", amount))
+		return nil
+	})
+	if err != nil {
+		log.Printf("Capture failed: %v", err)
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Void releases an authorization that has not been captured
+func (s *PaymentService) Void(ctx context.Context, req *pb.VoidRequest) (*pb.Transaction, error) {
+	log.Printf("Void request received for transaction: %v", req.GetTransactionId())
+
+	tx, err := s.ledger.update(req.GetTransactionId(), func(tx *pb.Transaction) error {
+		if tx.GetState() != pb.TransactionState_TRANSACTION_STATE_AUTHORIZED {
+			return invalidStateErr("void", tx)
+		}
+		if err := s.processor.Void(ctx, tx.GetTransactionId()); err != nil {
+			return err
+		}
+		tx.State = pb.TransactionState_TRANSACTION_STATE_VOIDED
+		tx.Events = append(tx.Events, s.ledger.event("void", tx.GetAuthorized()))
+		return nil
+	})
+	if err != nil {
+		log.Printf("Void failed: %v", err)
+		return nil, err
+	}
+	return tx, nil
+}
+
+// Refund returns some or all of a captured amount
+func (s *PaymentService) Refund(ctx context.Context, req *pb.RefundRequest) (*pb.Transaction, error) {
+	log.Printf("Refund request received for transaction: %v", req.GetTransactionId())
+
+	tx, err := s.ledger.update(req.GetTransactionId(), func(tx *pb.Transaction) error {
+		switch tx.GetState() {
+		case pb.TransactionState_TRANSACTION_STATE_CAPTURED, pb.TransactionState_TRANSACTION_STATE_PARTIALLY_REFUNDED:
+		default:
+			return invalidStateErr("refund", tx)
+		}
+
+		refunded := tx.GetRefunded()
+		if refunded == nil {
+			refunded = &pb.Money{CurrencyCode: tx.GetCaptured().GetCurrencyCode()}
+		}
+		negated := Negate(refunded)
+		remaining, err := Sum(tx.GetCaptured(), &negated)
+		if err != nil {
+			return err
+		}
+		amount := req.GetAmount()
+		if amount == nil {
+			amount = remaining
+		}
+		if err := checkAmount(amount, remaining, "remaining captured amount"); err != nil {
+			return err
+		}
+		if err := s.processor.Refund(ctx, tx.GetTransactionId(), amount); err != nil {
+			return err
+		}
+
+		tx.Refunded = Must(Sum(refunded, amount))
+		tx.State = pb.TransactionState_TRANSACTION_STATE_PARTIALLY_REFUNDED
+		if AreEquals(tx.GetRefunded(), tx.GetCaptured()) {
+			tx.State = pb.TransactionState_TRANSACTION_STATE_REFUNDED
+		}
+		tx.Events = append(tx.Events, s.ledger.event("refund", amount))
+		return nil
+	})
+	if err != nil {
+		log.Printf("Refund failed: %v", err)
+		return nil, err
+	}
+	return tx, nil
+}
+
+// GetTransaction looks up a transaction in the ledger
+func (s *PaymentService) GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.Transaction, error) {
+	log.Printf("GetTransaction request received for transaction: %v", req.GetTransactionId())
+	return s.ledger.get(req.GetTransactionId())
+}
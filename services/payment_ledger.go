@@ -0,0 +1,213 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// ledgerStore persists transactions. Save is called with the full
+// transaction after every change; Load returns the latest version of each.
+type ledgerStore interface {
+	Load() ([]*pb.Transaction, error)
+	Save(tx *pb.Transaction) error
+}
+
+// memoryLedgerStore keeps nothing beyond the ledger's own map.
+type memoryLedgerStore struct{}
+
+func (memoryLedgerStore) Load() ([]*pb.Transaction, error) { return nil, nil }
+func (memoryLedgerStore) Save(*pb.Transaction) error       { return nil }
+
+// fileLedgerStore appends every transaction version to a JSON-lines file and
+// replays it on load, so later lines win.
+type fileLedgerStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+func newFileLedgerStore(path string) *fileLedgerStore {
+	return &fileLedgerStore{path: path}
+}
+
+func (s *fileLedgerStore) Load() ([]*pb.Transaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	latest := map[string]int{}
+	var txs []*pb.Transaction
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; sc.Scan(); line++ {
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		tx := &pb.Transaction{}
+		if err := protojson.Unmarshal(sc.Bytes(), tx); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", s.path, line, err)
+		}
+		if i, ok := latest[tx.GetTransactionId()]; ok {
+			txs[i] = tx
+		} else {
+			latest[tx.GetTransactionId()] = len(txs)
+			txs = append(txs, tx)
+		}
+	}
+	return txs, sc.Err()
+}
+
+func (s *fileLedgerStore) Save(tx *pb.Transaction) error {
+	line, err := protojson.MarshalOptions{Multiline: false}.Marshal(tx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ledger records every payment transaction and enforces the lifecycle:
+//
+//	AUTHORIZED -> CAPTURED -> PARTIALLY_REFUNDED -> REFUNDED
+//	AUTHORIZED -> VOIDED
+//
+// Each transaction has its own lock, held across the gateway call, so two
+// operations on the same transaction cannot both pass the state check.
+type ledger struct {
+	mu      sync.Mutex
+	entries map[string]*ledgerEntry
+
+	store ledgerStore
+	now   func() time.Time
+}
+
+type ledgerEntry struct {
+	mu sync.Mutex
+	tx *pb.Transaction
+}
+
+func newLedger(store ledgerStore) (*ledger, error) {
+	txs, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+	l := &ledger{
+		entries: make(map[string]*ledgerEntry, len(txs)),
+		store:   store,
+		now:     time.Now,
+	}
+	for _, tx := range txs {
+		l.entries[tx.GetTransactionId()] = &ledgerEntry{tx: tx}
+	}
+	return l, nil
+}
+
+// event returns a transaction event of type typ stamped with the current
+// time.
+func (l *ledger) event(typ string, amount *pb.Money) *pb.TransactionEvent {
+	return &pb.TransactionEvent{
+		Type:          typ,
+		Amount:        amount,
+		TimestampUnix: l.now().Unix(),
+	}
+}
+
+// create records a new transaction.
+func (l *ledger) create(tx *pb.Transaction) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.entries[tx.GetTransactionId()]; ok {
+		return status.Errorf(codes.AlreadyExists, "transaction %s already exists", tx.GetTransactionId())
+	}
+	if err := l.store.Save(tx); err != nil {
+		return err
+	}
+	l.entries[tx.GetTransactionId()] = &ledgerEntry{tx: tx}
+	return nil
+}
+
+// update runs fn on a copy of the transaction while holding its lock. If fn
+// succeeds, the copy is saved and replaces the stored transaction.
+func (l *ledger) update(id string, fn func(tx *pb.Transaction) error) (*pb.Transaction, error) {
+	l.mu.Lock()
+	e, ok := l.entries[id]
+	l.mu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no transaction with ID %s", id)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	tx := proto.Clone(e.tx).(*pb.Transaction)
+	if err := fn(tx); err != nil {
+		return nil, err
+	}
+	if err := l.store.Save(tx); err != nil {
+		return nil, err
+	}
+	e.tx = tx
+	return proto.Clone(tx).(*pb.Transaction), nil
+}
+
+// get returns a copy of the transaction.
+func (l *ledger) get(id string) (*pb.Transaction, error) {
+	l.mu.Lock()
+	e, ok := l.entries[id]
+	l.mu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no transaction with ID %s", id)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return proto.Clone(e.tx).(*pb.Transaction), nil
+}
+
+// invalidStateErr reports an operation the transaction's state forbids.
+func invalidStateErr(op string, tx *pb.Transaction) error {
+	msg := fmt.Sprintf("cannot %s transaction %s in state %s", op, tx.GetTransactionId(), tx.GetState())
+	return paymentStatus(codes.FailedPrecondition, "INVALID_TRANSACTION_STATE", msg, "").Err()
+}
+
+// checkAmount verifies that amount is positive, in limit's currency and no
+// larger than limit.
+func checkAmount(amount, limit *pb.Money, what string) error {
+	if !IsValid(amount) || !IsPositive(amount) {
+		return paymentStatus(codes.InvalidArgument, "INVALID_AMOUNT", "amount must be positive", "amount").Err()
+	}
+	if amount.GetCurrencyCode() != limit.GetCurrencyCode() {
+		msg := fmt.Sprintf("amount currency %s does not match transaction currency %s", amount.GetCurrencyCode(), limit.GetCurrencyCode())
+		return paymentStatus(codes.InvalidArgument, "CURRENCY_MISMATCH", msg, "amount").Err()
+	}
+	if moneyToRat(amount).Cmp(moneyToRat(limit)) > 0 {
+		msg := fmt.Sprintf("amount %d.%09d exceeds %s %d.%09d", amount.GetUnits(), amount.GetNanos(), what, limit.GetUnits(), limit.GetNanos())
+		return paymentStatus(codes.FailedPrecondition, "AMOUNT_EXCEEDS_LIMIT", msg, "amount").Err()
+	}
+	return nil
+}
@@ -0,0 +1,425 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// PaymentProcessor moves money for a card that has already passed local
+// validation. Charge and Authorize return the gateway's transaction ID, which
+// the other methods take to act on an earlier authorization.
+type PaymentProcessor interface {
+	// Charge authorizes and captures amount in one step.
+	Charge(ctx context.Context, amount *pb.Money, card *pb.CreditCardInfo) (string, error)
+	// Authorize places a hold for amount without moving money.
+	Authorize(ctx context.Context, amount *pb.Money, card *pb.CreditCardInfo) (string, error)
+	// Capture collects amount, at most the authorized amount, from a hold.
+	Capture(ctx context.Context, transactionID string, amount *pb.Money) error
+	// Void releases a hold that has not been captured.
+	Void(ctx context.Context, transactionID string) error
+	// Refund returns amount of a captured transaction to the card.
+	Refund(ctx context.Context, transactionID string, amount *pb.Money) error
+}
+
+type CardDeclinedErr struct{}
+
+func (e CardDeclinedErr) Error() string {
+	return "card declined"
+}
+
+func (e CardDeclinedErr) GRPCStatus() *status.Status {
+	return paymentStatus(codes.FailedPrecondition, "CARD_DECLINED", e.Error(), "")
+}
+
+type InsufficientFundsErr struct{}
+
+func (e InsufficientFundsErr) Error() string {
+	return "insufficient funds"
+}
+
+func (e InsufficientFundsErr) GRPCStatus() *status.Status {
+	return paymentStatus(codes.ResourceExhausted, "INSUFFICIENT_FUNDS", e.Error(), "")
+}
+
+type FraudHoldErr struct{}
+
+func (e FraudHoldErr) Error() string {
+	return "payment held for fraud review"
+}
+
+func (e FraudHoldErr) GRPCStatus() *status.Status {
+	return paymentStatus(codes.PermissionDenied, "FRAUD_HOLD", e.Error(), "")
+}
+
+type GatewayTimeoutErr struct{}
+
+func (e GatewayTimeoutErr) Error() string {
+	return "payment gateway timed out"
+}
+
+func (e GatewayTimeoutErr) GRPCStatus() *status.Status {
+	return paymentStatus(codes.DeadlineExceeded, "GATEWAY_TIMEOUT", e.Error(), "")
+}
+
+type GatewayErr struct {
+	Reason string
+}
+
+func (e GatewayErr) Error() string {
+	return "payment gateway error: " + e.Reason
+}
+
+func (e GatewayErr) GRPCStatus() *status.Status {
+	return paymentStatus(codes.Unavailable, "GATEWAY_ERROR", e.Error(), "")
+}
+
+// Scenario card numbers recognised by the fake gateway. Any other valid card
+// is charged successfully, subject to the configured error rate.
+const (
+	cardDecline           = "4000000000000002"
+	cardInsufficientFunds = "4000000000009995"
+	cardTimeout           = "4000000000000119"
+	cardFraudHold         = "4100000000000019"
+)
+
+// newPaymentProcessorFromEnv picks the processor named by PAYMENT_PROCESSOR
+// ("fake" by default, or "http") and configures it from the environment.
+func newPaymentProcessorFromEnv() (PaymentProcessor, error) {
+	switch p := strings.ToLower(os.Getenv("PAYMENT_PROCESSOR")); p {
+	case "", "fake":
+		cfg := fakeGatewayConfig{
+			Latency:   envDuration("PAYMENT_LATENCY", 0),
+			Jitter:    envDuration("PAYMENT_LATENCY_JITTER", 0),
+			Timeout:   envDuration("PAYMENT_TIMEOUT", 5*time.Second),
+			ErrorRate: envFloat("PAYMENT_ERROR_RATE", 0),
+			Seed:      envInt64("PAYMENT_SEED", 1),
+		}
+		log.Printf("Using fake payment gateway: %+v", cfg)
+		return newFakeGateway(cfg), nil
+	case "http":
+		var baseURL, apiKey string
+		mustMapEnv(&baseURL, "PAYMENT_GATEWAY_URL")
+		mustMapEnv(&apiKey, "PAYMENT_GATEWAY_KEY")
+		log.Printf("Using HTTP payment gateway at %s", baseURL)
+		return newHTTPGateway(baseURL, apiKey, &http.Client{
+			Timeout: envDuration("PAYMENT_TIMEOUT", 5*time.Second),
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown PAYMENT_PROCESSOR %q", p)
+	}
+}
+
+func envDuration(key string, def time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+		log.Printf("Ignoring invalid %s=%q", key, v)
+	}
+	return def
+}
+
+func envInt64(key string, def int64) int64 {
+	if v := os.Getenv(key); v != "" {
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return i
+		}
+		log.Printf("Ignoring invalid %s=%q", key, v)
+	}
+	return def
+}
+
+func envFloat(key string, def float64) float64 {
+	if v := os.Getenv(key); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+		log.Printf("Ignoring invalid %s=%q", key, v)
+	}
+	return def
+}
+
+type fakeGatewayConfig struct {
+	// Latency is added to every charge, plus a random amount up to Jitter.
+	Latency time.Duration
+	Jitter  time.Duration
+
+	// Timeout is how long the timeout scenario card hangs before failing.
+	Timeout time.Duration
+
+	// ErrorRate is the probability in [0, 1] that an otherwise successful
+	// charge or authorization fails with a GatewayErr. Captures, voids and
+	// refunds of an existing transaction never fail at random, so that a
+	// simulated outage cannot strand a hold or a payment.
+	ErrorRate float64
+
+	// Seed makes latency, random failures and transaction IDs reproducible.
+	Seed int64
+}
+
+// fakeGateway is a deterministic in-process PaymentProcessor.
+type fakeGateway struct {
+	cfg fakeGatewayConfig
+
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+func newFakeGateway(cfg fakeGatewayConfig) *fakeGateway {
+	return &fakeGateway{
+		cfg: cfg,
+		rng: rand.New(rand.NewSource(cfg.Seed)),
+	}
+}
+
+// Charge implements PaymentProcessor.
+func (g *fakeGateway) Charge(ctx context.Context, amount *pb.Money, card *pb.CreditCardInfo) (string, error) {
+	return g.simulate(ctx, normalizeCardNumber(card.GetCreditCardNumber()), true)
+}
+
+// Authorize implements PaymentProcessor.
+func (g *fakeGateway) Authorize(ctx context.Context, amount *pb.Money, card *pb.CreditCardInfo) (string, error) {
+	return g.simulate(ctx, normalizeCardNumber(card.GetCreditCardNumber()), true)
+}
+
+// Capture implements PaymentProcessor.
+func (g *fakeGateway) Capture(ctx context.Context, transactionID string, amount *pb.Money) error {
+	_, err := g.simulate(ctx, "", false)
+	return err
+}
+
+// Void implements PaymentProcessor.
+func (g *fakeGateway) Void(ctx context.Context, transactionID string) error {
+	_, err := g.simulate(ctx, "", false)
+	return err
+}
+
+// Refund implements PaymentProcessor.
+func (g *fakeGateway) Refund(ctx context.Context, transactionID string, amount *pb.Money) error {
+	_, err := g.simulate(ctx, "", false)
+	return err
+}
+
+// simulate applies the configured latency, the error rate if mayFail, and
+// the scenario for number if it is one of the scenario cards. It returns a
+// new transaction ID on success.
+func (g *fakeGateway) simulate(ctx context.Context, number string, mayFail bool) (string, error) {
+	g.mu.Lock()
+	delay := g.cfg.Latency
+	if g.cfg.Jitter > 0 {
+		delay += time.Duration(g.rng.Int63n(int64(g.cfg.Jitter)))
+	}
+	fail := mayFail && g.cfg.ErrorRate > 0 && g.rng.Float64() < g.cfg.ErrorRate
+	txID, err := uuid.NewRandomFromReader(g.rng)
+	g.mu.Unlock()
+	if err != nil {
+		return "", err
+	}
+
+	if number == cardTimeout {
+		delay = g.cfg.Timeout
+	}
+	if err := sleepCtx(ctx, delay); err != nil {
+		return "", GatewayTimeoutErr{}
+	}
+
+	switch {
+	case number == cardDecline:
+		return "", CardDeclinedErr{}
+	case number == cardInsufficientFunds:
+		return "", InsufficientFundsErr{}
+	case number == cardFraudHold:
+		return "", FraudHoldErr{}
+	case number == cardTimeout:
+		return "", GatewayTimeoutErr{}
+	case fail:
+		return "", GatewayErr{Reason: "simulated processing error"}
+	}
+	return txID.String(), nil
+}
+
+// sleepCtx waits for d or until ctx is done, whichever comes first.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// httpGateway is a PaymentProcessor for a Stripe-like REST API: requests are
+// form-encoded POSTs authenticated with a bearer key. Authorizations are
+// uncaptured charges, and voids are refunds of an uncaptured charge.
+type httpGateway struct {
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+func newHTTPGateway(baseURL, apiKey string, client *http.Client) *httpGateway {
+	return &httpGateway{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+		client:  client,
+	}
+}
+
+type gatewayObject struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+type gatewayError struct {
+	Error struct {
+		Type        string `json:"type"`
+		Code        string `json:"code"`
+		DeclineCode string `json:"decline_code"`
+		Message     string `json:"message"`
+	} `json:"error"`
+}
+
+// Charge implements PaymentProcessor.
+func (g *httpGateway) Charge(ctx context.Context, amount *pb.Money, card *pb.CreditCardInfo) (string, error) {
+	return g.createCharge(ctx, amount, card, true)
+}
+
+// Authorize implements PaymentProcessor.
+func (g *httpGateway) Authorize(ctx context.Context, amount *pb.Money, card *pb.CreditCardInfo) (string, error) {
+	return g.createCharge(ctx, amount, card, false)
+}
+
+// Capture implements PaymentProcessor.
+func (g *httpGateway) Capture(ctx context.Context, transactionID string, amount *pb.Money) error {
+	form := url.Values{"amount": {strconv.FormatInt(minorUnits(amount), 10)}}
+	_, err := g.post(ctx, "/v1/charges/"+url.PathEscape(transactionID)+"/capture", form, "succeeded")
+	return err
+}
+
+// Void implements PaymentProcessor.
+func (g *httpGateway) Void(ctx context.Context, transactionID string) error {
+	form := url.Values{"charge": {transactionID}}
+	_, err := g.post(ctx, "/v1/refunds", form, "succeeded")
+	return err
+}
+
+// Refund implements PaymentProcessor.
+func (g *httpGateway) Refund(ctx context.Context, transactionID string, amount *pb.Money) error {
+	form := url.Values{
+		"charge": {transactionID},
+		"amount": {strconv.FormatInt(minorUnits(amount), 10)},
+	}
+	_, err := g.post(ctx, "/v1/refunds", form, "succeeded")
+	return err
+}
+
+func (g *httpGateway) createCharge(ctx context.Context, amount *pb.Money, card *pb.CreditCardInfo, capture bool) (string, error) {
+	number := normalizeCardNumber(card.GetCreditCardNumber())
+	form := url.Values{
+		"amount":          {strconv.FormatInt(minorUnits(amount), 10)},
+		"currency":        {strings.ToLower(amount.GetCurrencyCode())},
+		"capture":         {strconv.FormatBool(capture)},
+		"card[number]":    {number},
+		"card[exp_month]": {strconv.Itoa(int(card.GetCreditCardExpirationMonth()))},
+		"card[exp_year]":  {strconv.Itoa(int(card.GetCreditCardExpirationYear()))},
+		"card[cvc]":       {cardCVV(card, detectNetwork(number))},
+	}
+	obj, err := g.post(ctx, "/v1/charges", form, "succeeded")
+	if err != nil {
+		return "", err
+	}
+	return obj.ID, nil
+}
+
+// post sends form to path and decodes the returned object, which must have
+// an ID and wantStatus.
+func (g *httpGateway) post(ctx context.Context, path string, form url.Values, wantStatus string) (*gatewayObject, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.baseURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+g.apiKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		var uerr *url.Error
+		if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &uerr) && uerr.Timeout()) {
+			return nil, GatewayTimeoutErr{}
+		}
+		return nil, GatewayErr{Reason: err.Error()}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		var ge gatewayError
+		if err := json.NewDecoder(resp.Body).Decode(&ge); err != nil {
+			return nil, GatewayErr{Reason: resp.Status}
+		}
+		return nil, ge.toErr(resp.Status)
+	}
+
+	var obj gatewayObject
+	if err := json.NewDecoder(resp.Body).Decode(&obj); err != nil {
+		return nil, GatewayErr{Reason: fmt.Sprintf("malformed response: %v", err)}
+	}
+	if obj.ID == "" || obj.Status != wantStatus {
+		return nil, GatewayErr{Reason: fmt.Sprintf("unexpected status %q", obj.Status)}
+	}
+	return &obj, nil
+}
+
+// toErr maps a gateway error body to one of the payment error types.
+func (ge gatewayError) toErr(status string) error {
+	e := ge.Error
+	switch {
+	case e.DeclineCode == "insufficient_funds":
+		return InsufficientFundsErr{}
+	case e.DeclineCode == "fraudulent":
+		return FraudHoldErr{}
+	case e.Code == "card_declined":
+		return CardDeclinedErr{}
+	case e.Message != "":
+		return GatewayErr{Reason: e.Message}
+	default:
+		return GatewayErr{Reason: status}
+	}
+}
+
+// zeroDecimalCurrencies have no minor unit, so amounts are sent in whole units.
+var zeroDecimalCurrencies = map[string]bool{
+	"JPY": true,
+	"KRW": true,
+	"ISK": true,
+}
+
+// minorUnits converts m into the smallest currency unit, truncating anything
+// below it.
+func minorUnits(m *pb.Money) int64 {
+	if zeroDecimalCurrencies[m.GetCurrencyCode()] {
+		return m.GetUnits()
+	}
+	return m.GetUnits()*100 + int64(m.GetNanos()/10000000)
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+func TestFakeGatewayErrorRateOnlyFailsNewTransactions(t *testing.T) {
+	g := newFakeGateway(fakeGatewayConfig{ErrorRate: 1, Seed: 1})
+	ctx := context.Background()
+	card := &pb.CreditCardInfo{CreditCardNumber: "4432801561520454"}
+
+	if _, err := g.Authorize(ctx, usd(10, 0), card); !errors.As(err, new(GatewayErr)) {
+		t.Errorf("Authorize: err = %v, want a GatewayErr", err)
+	}
+	if _, err := g.Charge(ctx, usd(10, 0), card); !errors.As(err, new(GatewayErr)) {
+		t.Errorf("Charge: err = %v, want a GatewayErr", err)
+	}
+	if err := g.Capture(ctx, "tx", usd(10, 0)); err != nil {
+		t.Errorf("Capture: %v", err)
+	}
+	if err := g.Void(ctx, "tx"); err != nil {
+		t.Errorf("Void: %v", err)
+	}
+	if err := g.Refund(ctx, "tx", usd(10, 0)); err != nil {
+		t.Errorf("Refund: %v", err)
+	}
+}
+
+// TestHTTPGateway runs the HTTP gateway against a stub of the REST API.
+func TestHTTPGateway(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		status  int
+		body    string
+		delay   time.Duration
+		wantTx  string
+		wantErr error
+	}{
+		{name: "success", status: 200, body: `{"id": "ch_1", "status": "succeeded"}`, wantTx: "ch_1"},
+		{name: "declined", status: 402, body: `{"error": {"type": "card_error", "code": "card_declined", "decline_code": "generic_decline"}}`, wantErr: CardDeclinedErr{}},
+		{name: "insufficient funds", status: 402, body: `{"error": {"type": "card_error", "code": "card_declined", "decline_code": "insufficient_funds"}}`, wantErr: InsufficientFundsErr{}},
+		{name: "fraud", status: 402, body: `{"error": {"type": "card_error", "code": "card_declined", "decline_code": "fraudulent"}}`, wantErr: FraudHoldErr{}},
+		{name: "api error", status: 400, body: `{"error": {"type": "invalid_request_error", "message": "bad currency"}}`, wantErr: GatewayErr{Reason: "bad currency"}},
+		{name: "unparsable error", status: 502, body: `<html>bad gateway</html>`, wantErr: GatewayErr{Reason: "502 Bad Gateway"}},
+		{name: "unexpected status", status: 200, body: `{"id": "ch_1", "status": "pending"}`, wantErr: GatewayErr{Reason: `unexpected status "pending"`}},
+		{name: "timeout", status: 200, body: `{"id": "ch_1", "status": "succeeded"}`, delay: time.Second, wantErr: GatewayTimeoutErr{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v1/charges" {
+					t.Errorf("request %s %s, want POST /v1/charges", r.Method, r.URL.Path)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer sk_test" {
+					t.Errorf("Authorization = %q", got)
+				}
+				r.ParseForm()
+				for k, want := range map[string]string{
+					"amount":       "1999",
+					"currency":     "usd",
+					"capture":      "false",
+					"card[number]": "4432801561520454",
+					"card[cvc]":    "042",
+				} {
+					if got := r.PostForm.Get(k); got != want {
+						t.Errorf("form %s = %q, want %q", k, got, want)
+					}
+				}
+				select {
+				case <-time.After(tc.delay):
+				case <-r.Context().Done():
+					return
+				}
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
+			}))
+			defer srv.Close()
+
+			g := newHTTPGateway(srv.URL+"/", "sk_test", &http.Client{Timeout: 100 * time.Millisecond})
+			tx, err := g.Authorize(context.Background(), usd(19, 990000000), &pb.CreditCardInfo{
+				CreditCardNumber:          "4432 8015 6152 0454",
+				CreditCardCvv:             42,
+				CreditCardExpirationMonth: 1,
+				CreditCardExpirationYear:  cardExpirationYear,
+			})
+			if tx != tc.wantTx || err != tc.wantErr {
+				t.Errorf("Authorize = %q, %v; want %q, %v", tx, err, tc.wantTx, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewPaymentProcessorFromEnv(t *testing.T) {
+	t.Setenv("PAYMENT_PROCESSOR", "fake")
+	t.Setenv("PAYMENT_SEED", "9007199254740993") // not representable as a float64
+	p, err := newPaymentProcessorFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seed := p.(*fakeGateway).cfg.Seed; seed != 9007199254740993 {
+		t.Errorf("seed = %d, want 9007199254740993", seed)
+	}
+
+	t.Setenv("PAYMENT_PROCESSOR", "carrier-pigeon")
+	if _, err := newPaymentProcessorFromEnv(); err == nil {
+		t.Error("unknown PAYMENT_PROCESSOR accepted")
+	}
+}
@@ -7,8 +7,8 @@ import (
 	"net"
 	"os"
 	"os/signal"
-	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -22,22 +22,51 @@ import (
 	"github.com/opentracing/opentracing-go"
 )
 
+const productCatalogFilePath = "data/products.json"
+
 // ProductCatalogService implements the ProductCatalogService
 type ProductCatalogService struct {
-	port    int
-	catalog pb.ListProductsResponse
+	port int
+	// path is the catalog file, which admin RPCs write back to.
+	path string
+	// adminToken authorizes admin RPCs. They are disabled if it is empty.
+	adminToken string
+	// writeMu serializes admin changes and reloads of the catalog file.
+	writeMu sync.Mutex
+
+	// catalog is replaced, never modified, when the file is reloaded, so
+	// requests can use a snapshot without locking.
+	catalog atomic.Pointer[productCatalog]
 
 	pb.ProductCatalogServiceServer
 
-	mu            sync.RWMutex
-	extraLatency  time.Duration
-	reloadCatalog bool
+	extraLatency time.Duration
+	// watching is set while changes to the catalog file are picked up.
+	watching     atomic.Bool
+	pollInterval time.Duration
+}
+
+// productCatalog is an immutable snapshot of the catalog file.
+type productCatalog struct {
+	products []*pb.Product
+	byID     map[string]*pb.Product
+	index    *searchIndex
+	// modTime and size identify the file version the snapshot was read from.
+	modTime time.Time
+	size    int64
 }
 
 // NewProductCatalogService creates a new ProductCatalogService
 func NewProductCatalogService(port int) *ProductCatalogService {
 	svc := &ProductCatalogService{
-		port: port,
+		port:         port,
+		path:         productCatalogFilePath,
+		adminToken:   os.Getenv("PRODUCT_CATALOG_ADMIN_TOKEN"),
+		pollInterval: envDuration("PRODUCT_CATALOG_POLL_INTERVAL", 2*time.Second),
+	}
+
+	if path := os.Getenv("PRODUCT_CATALOG_FILE"); path != "" {
+		svc.path = path
 	}
 
 	// Initialize extra latency from environment variable
@@ -47,70 +76,172 @@ func NewProductCatalogService(port int) *ProductCatalogService {
 		}
 	}
 
-	// Signal handling for reloading
+	// Load initial catalog
+	if err := svc.loadCatalog(); err != nil {
+		log.Fatalf("Failed to load catalog: %v", err)
+	}
+
+	// SIGUSR1 starts picking up changes to the catalog file, SIGUSR2 stops.
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGUSR1, syscall.SIGUSR2)
 	go func() {
-		for {
-			sig := <-sigs
+		for sig := range sigs {
 			switch sig {
 			case syscall.SIGUSR1:
 				log.Println("Enabling catalog reload")
-				svc.mu.Lock()
-				svc.reloadCatalog = true
-				svc.mu.Unlock()
+				svc.watching.Store(true)
 			case syscall.SIGUSR2:
 				log.Println("Disabling catalog reload")
-				svc.mu.Lock()
-				svc.reloadCatalog = false
-				svc.mu.Unlock()
+				svc.watching.Store(false)
 			}
 		}
 	}()
-
-	// Load initial catalog
-	if err := svc.loadCatalog(&svc.catalog); err != nil {
-		log.Fatalf("Failed to load catalog: %v", err)
-	}
+	go svc.watchCatalog()
 
 	return svc
 }
 
-// loadCatalog loads the product catalog from a file.
-func (s *ProductCatalogService) loadCatalog(catalog *pb.ListProductsResponse) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// loadCatalog reads the catalog file and swaps in a new snapshot. The old
+// snapshot stays in use if the file cannot be read or parsed.
+func (s *ProductCatalogService) loadCatalog() error {
+	// Hold writeMu so that a reload cannot swap in a file read from before
+	// an admin change over the snapshot of that change.
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
 
-	// Read the JSON file
-	catalogJSON, err := os.ReadFile("data/products.json")
+	info, err := os.Stat(s.path)
 	if err != nil {
 		return err
 	}
-
-	// Unmarshal the JSON into the Protobuf message
-	if err := protojson.Unmarshal(catalogJSON, catalog); err != nil {
+	catalogJSON, err := os.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+	var catalog pb.ListProductsResponse
+	if err := protojson.Unmarshal(catalogJSON, &catalog); err != nil {
 		return err
 	}
 
+	snap, err := newProductCatalog(catalog.Products)
+	if err != nil {
+		return err
+	}
+	snap.modTime, snap.size = info.ModTime(), info.Size()
+	s.catalog.Store(snap)
 	return nil
 }
 
-// parseCatalog parses the current catalog state
-func (s *ProductCatalogService) parseCatalog() []*pb.Product {
-	if s.reloadCatalog || len(s.catalog.Products) == 0 {
-		err := s.loadCatalog(&s.catalog)
-		if err != nil {
-			return []*pb.Product{}
+// newProductCatalog indexes products, which must have unique IDs and SKUs.
+func newProductCatalog(products []*pb.Product) (*productCatalog, error) {
+	snap := &productCatalog{
+		products: products,
+		byID:     make(map[string]*pb.Product, len(products)),
+		index:    newSearchIndex(products),
+	}
+	skus := map[string]bool{}
+	for _, p := range products {
+		if _, dup := snap.byID[p.GetId()]; dup {
+			return nil, fmt.Errorf("duplicate product ID %s", p.GetId())
+		}
+		snap.byID[p.GetId()] = p
+		for _, v := range p.GetVariants() {
+			if v.GetSku() == "" || skus[v.GetSku()] {
+				return nil, fmt.Errorf("product %s: SKUs must be unique and non-empty, got %q", p.GetId(), v.GetSku())
+			}
+			skus[v.GetSku()] = true
+		}
+	}
+	return snap, nil
+}
+
+// findVariant returns the variant of p with the given SKU. Products without
+// variants are bought without a SKU, in which case it returns nil.
+func findVariant(p *pb.Product, sku string) (*pb.ProductVariant, error) {
+	if len(p.GetVariants()) == 0 {
+		if sku != "" {
+			return nil, fmt.Errorf("product %s has no variants, got SKU %s", p.GetId(), sku)
+		}
+		return nil, nil
+	}
+	if sku == "" {
+		return nil, fmt.Errorf("product %s needs a SKU", p.GetId())
+	}
+	for _, v := range p.GetVariants() {
+		if v.GetSku() == sku {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("product %s has no SKU %s", p.GetId(), sku)
+}
+
+// variantPrice returns the USD price of v, a variant of p, or of p itself if
+// v is nil.
+func variantPrice(p *pb.Product, v *pb.ProductVariant) *pb.Money {
+	if v.GetPriceUsd() != nil {
+		return v.GetPriceUsd()
+	}
+	return p.GetPriceUsd()
+}
+
+// listPrice returns the price the catalog lists for v, a variant of p that
+// may be nil, in currency. Prices in other currencies have to be converted
+// from variantPrice.
+func listPrice(p *pb.Product, v *pb.ProductVariant, currency string) (*pb.Money, bool) {
+	if currency == "USD" {
+		return variantPrice(p, v), true
+	}
+	prices := p.GetLocalPrices()
+	if v.GetPriceUsd() != nil {
+		prices = v.GetLocalPrices()
+	}
+	for _, m := range prices {
+		if m.GetCurrencyCode() == currency {
+			return m, true
 		}
 	}
+	return nil, false
+}
+
+// variantPicture is like variantPrice for the picture.
+func variantPicture(p *pb.Product, v *pb.ProductVariant) string {
+	if v.GetPicture() != "" {
+		return v.GetPicture()
+	}
+	return p.GetPicture()
+}
 
-	return s.catalog.Products
+// watchCatalog polls the catalog file while watching is set and reloads it
+// when its modification time or size changes.
+func (s *ProductCatalogService) watchCatalog() {
+	t := time.NewTicker(s.pollInterval)
+	defer t.Stop()
+	for range t.C {
+		if !s.watching.Load() {
+			continue
+		}
+		info, err := os.Stat(s.path)
+		if err != nil {
+			log.Printf("Failed to stat catalog: %v", err)
+			continue
+		}
+		if cur := s.catalog.Load(); info.ModTime().Equal(cur.modTime) && info.Size() == cur.size {
+			continue
+		}
+		if err := s.loadCatalog(); err != nil {
+			log.Printf("Failed to reload catalog, keeping the previous one: %v", err)
+			continue
+		}
+		log.Printf("Reloaded catalog with %d products", len(s.catalog.Load().products))
+	}
 }
 
 // Run starts the gRPC server
 func (s *ProductCatalogService) Run() error {
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer())),
+		grpc.ChainUnaryInterceptor(
+			otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer()),
+			s.adminAuthInterceptor,
+		),
 	}
 	srv := grpc.NewServer(opts...)
 	pb.RegisterProductCatalogServiceServer(srv, s)
@@ -130,7 +261,7 @@ func (s *ProductCatalogService) ListProducts(ctx context.Context, req *pb.EmptyU
 	time.Sleep(s.extraLatency)
 
 	response := &pb.ListProductsResponse{
-		Products: s.parseCatalog(),
+		Products: s.catalog.Load().products,
 	}
 
 	log.Printf("ListProducts: Responding with %d products\n", len(response.Products))
@@ -138,21 +269,31 @@ func (s *ProductCatalogService) ListProducts(ctx context.Context, req *pb.EmptyU
 	return response, nil
 }
 
+// ListProductsPage lists one page of the catalog, sorted and filtered as
+// requested
+func (s *ProductCatalogService) ListProductsPage(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	log.Printf("ListProductsPage: Received request sort=%s categories=%v", req.GetSortOrder(), req.GetCategories())
+
+	time.Sleep(s.extraLatency)
+
+	resp, err := pageProducts(s.catalog.Load().products, req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Printf("ListProductsPage: Responding with %d of %d products\n", len(resp.Products), resp.TotalSize)
+
+	return resp, nil
+}
+
 // GetProduct retrieves a product by its ID
 func (s *ProductCatalogService) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.Product, error) {
 	log.Printf("GetProduct: Received request for product ID %s\n", req.Id)
 
 	time.Sleep(s.extraLatency)
 
-	var found *pb.Product
-	for i := 0; i < len(s.parseCatalog()); i++ {
-		if req.Id == s.parseCatalog()[i].Id {
-			found = s.parseCatalog()[i]
-			break
-		}
-	}
-
-	if found == nil {
+	found, ok := s.catalog.Load().byID[req.Id]
+	if !ok {
 		log.Printf("GetProduct: Product with ID %s not found\n", req.Id)
 		return nil, status.Errorf(codes.NotFound, "no product with ID %s", req.Id)
 	}
@@ -161,21 +302,19 @@ func (s *ProductCatalogService) GetProduct(ctx context.Context, req *pb.GetProdu
 	return found, nil
 }
 
-// SearchProducts searches for products matching a query
+// SearchProducts searches for products matching a query and filters, best
+// matches first
 func (s *ProductCatalogService) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
 	log.Printf("SearchProducts: Received request with query: %s\n", req.Query)
 
 	time.Sleep(s.extraLatency)
 
-	var ps []*pb.Product
-	for _, product := range s.parseCatalog() {
-		if strings.Contains(strings.ToLower(product.Name), strings.ToLower(req.Query)) ||
-			strings.Contains(strings.ToLower(product.Description), strings.ToLower(req.Query)) {
-			ps = append(ps, product)
-		}
+	resp, err := s.catalog.Load().index.search(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	log.Printf("SearchProducts: Search completed. Query: %s, Results: %d\n", req.Query, len(ps))
+	log.Printf("SearchProducts: Search completed. Query: %s, Results: %d\n", req.Query, resp.TotalResults)
 
-	return &pb.SearchProductsResponse{Results: ps}, nil
+	return resp, nil
 }
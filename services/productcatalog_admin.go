@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// adminMethods are the ProductCatalogService RPCs that need the admin token.
+var adminMethods = map[string]bool{
+	pb.ProductCatalogService_CreateProduct_FullMethodName:  true,
+	pb.ProductCatalogService_UpdateProduct_FullMethodName:  true,
+	pb.ProductCatalogService_DeleteProduct_FullMethodName:  true,
+	pb.ProductCatalogService_ImportProducts_FullMethodName: true,
+}
+
+var (
+	productIDPattern       = regexp.MustCompile(`^[A-Z0-9]{4,32}$`)
+	productCategoryPattern = regexp.MustCompile(`^[a-z][a-z0-9-]{0,31}$`)
+	currencyCodePattern    = regexp.MustCompile(`^[A-Z]{3}$`)
+	productSKUPattern      = regexp.MustCompile(`^[A-Z0-9][A-Z0-9-]{3,47}$`)
+	productPicturePattern  = regexp.MustCompile(`^/static/img/products/[a-z0-9][a-z0-9_-]*\.(jpg|jpeg|png|webp)$`)
+)
+
+// adminAuthInterceptor rejects admin RPCs unless they carry the admin token
+// as a bearer token.
+func (s *ProductCatalogService) adminAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !adminMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	if s.adminToken == "" {
+		return nil, status.Errorf(codes.PermissionDenied, "catalog admin API is disabled")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		token, ok := strings.CutPrefix(v, "Bearer ")
+		if ok && subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) == 1 {
+			return handler(ctx, req)
+		}
+	}
+	return nil, status.Errorf(codes.Unauthenticated, "missing or invalid admin token")
+}
+
+// validateProduct checks the fields merchandisers can set.
+func validateProduct(p *pb.Product) error {
+	switch {
+	case p == nil:
+		return fmt.Errorf("product is required")
+	case !productIDPattern.MatchString(p.GetId()):
+		return fmt.Errorf("id %q must be 4 to 32 uppercase letters or digits", p.GetId())
+	case strings.TrimSpace(p.GetName()) == "" || len(p.GetName()) > 200:
+		return fmt.Errorf("product %s: name must be 1 to 200 characters", p.GetId())
+	case len(p.GetDescription()) > 2000:
+		return fmt.Errorf("product %s: description must be at most 2000 characters", p.GetId())
+	case !productPicturePattern.MatchString(p.GetPicture()):
+		return fmt.Errorf("product %s: picture must be an image under /static/img/products/", p.GetId())
+	case p.GetPriceUsd().GetCurrencyCode() != "USD" || !IsValid(p.GetPriceUsd()) || !IsPositive(p.GetPriceUsd()):
+		return fmt.Errorf("product %s: price_usd must be a positive USD amount", p.GetId())
+	case len(p.GetCategories()) == 0:
+		return fmt.Errorf("product %s: at least one category is required", p.GetId())
+	case p.GetWeightGrams() < 0 || p.GetDimensions().GetLengthCm() < 0 ||
+		p.GetDimensions().GetWidthCm() < 0 || p.GetDimensions().GetHeightCm() < 0:
+		return fmt.Errorf("product %s: weight and dimensions must not be negative", p.GetId())
+	}
+	if err := validateLocalPrices(p.GetLocalPrices()); err != nil {
+		return fmt.Errorf("product %s: %w", p.GetId(), err)
+	}
+	for _, c := range p.GetCategories() {
+		if !productCategoryPattern.MatchString(c) {
+			return fmt.Errorf("product %s: category %q must be a lowercase word", p.GetId(), c)
+		}
+	}
+	for _, v := range p.GetVariants() {
+		if err := validateVariant(v); err != nil {
+			return fmt.Errorf("product %s: %w", p.GetId(), err)
+		}
+	}
+	return nil
+}
+
+// validateVariant checks a variant. Uniqueness of SKUs across the catalog is
+// checked when the catalog is indexed.
+func validateVariant(v *pb.ProductVariant) error {
+	switch {
+	case !productSKUPattern.MatchString(v.GetSku()):
+		return fmt.Errorf("SKU %q must be 4 to 48 uppercase letters, digits or dashes", v.GetSku())
+	case len(v.GetAttributes()) == 0:
+		return fmt.Errorf("variant %s: at least one attribute is required", v.GetSku())
+	case v.GetPriceUsd() != nil && (v.GetPriceUsd().GetCurrencyCode() != "USD" || !IsValid(v.GetPriceUsd()) || !IsPositive(v.GetPriceUsd())):
+		return fmt.Errorf("variant %s: price_usd must be a positive USD amount", v.GetSku())
+	case len(v.GetLocalPrices()) > 0 && v.GetPriceUsd() == nil:
+		return fmt.Errorf("variant %s: local_prices need a price_usd", v.GetSku())
+	case v.GetPicture() != "" && !productPicturePattern.MatchString(v.GetPicture()):
+		return fmt.Errorf("variant %s: picture must be an image under /static/img/products/", v.GetSku())
+	}
+	if err := validateLocalPrices(v.GetLocalPrices()); err != nil {
+		return fmt.Errorf("variant %s: %w", v.GetSku(), err)
+	}
+	for _, a := range v.GetAttributes() {
+		if strings.TrimSpace(a.GetName()) == "" || strings.TrimSpace(a.GetValue()) == "" {
+			return fmt.Errorf("variant %s: attribute names and values are required", v.GetSku())
+		}
+	}
+	return nil
+}
+
+// validateLocalPrices checks that prices are positive and that there is at
+// most one per currency other than USD, which price_usd is for.
+func validateLocalPrices(prices []*pb.Money) error {
+	seen := map[string]bool{}
+	for _, m := range prices {
+		code := m.GetCurrencyCode()
+		switch {
+		case !currencyCodePattern.MatchString(code) || code == "USD":
+			return fmt.Errorf("local price currency %q must be an ISO 4217 code other than USD", code)
+		case seen[code]:
+			return fmt.Errorf("more than one local price in %s", code)
+		case !IsValid(m) || !IsPositive(m):
+			return fmt.Errorf("local price in %s must be positive", code)
+		}
+		seen[code] = true
+	}
+	return nil
+}
+
+// CreateProduct adds a product to the catalog
+func (s *ProductCatalogService) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.Product, error) {
+	p := req.GetProduct()
+	if err := validateProduct(p); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err := s.updateCatalog(func(products []*pb.Product) ([]*pb.Product, error) {
+		for _, existing := range products {
+			if existing.GetId() == p.GetId() {
+				return nil, status.Errorf(codes.AlreadyExists, "product %s already exists", p.GetId())
+			}
+		}
+		return append(products, proto.Clone(p).(*pb.Product)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("CreateProduct: Created product %s", p.GetId())
+	return p, nil
+}
+
+// UpdateProduct replaces a product in the catalog
+func (s *ProductCatalogService) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
+	p := req.GetProduct()
+	if err := validateProduct(p); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err := s.updateCatalog(func(products []*pb.Product) ([]*pb.Product, error) {
+		for i, existing := range products {
+			if existing.GetId() == p.GetId() {
+				products[i] = proto.Clone(p).(*pb.Product)
+				return products, nil
+			}
+		}
+		return nil, status.Errorf(codes.NotFound, "no product with ID %s", p.GetId())
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("UpdateProduct: Updated product %s", p.GetId())
+	return p, nil
+}
+
+// DeleteProduct removes a product from the catalog
+func (s *ProductCatalogService) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.Empty, error) {
+	err := s.updateCatalog(func(products []*pb.Product) ([]*pb.Product, error) {
+		for i, existing := range products {
+			if existing.GetId() == req.GetId() {
+				return append(products[:i], products[i+1:]...), nil
+			}
+		}
+		return nil, status.Errorf(codes.NotFound, "no product with ID %s", req.GetId())
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("DeleteProduct: Deleted product %s", req.GetId())
+	return &pb.Empty{}, nil
+}
+
+// ImportProducts creates or replaces many products at once
+func (s *ProductCatalogService) ImportProducts(ctx context.Context, req *pb.ImportProductsRequest) (*pb.ImportProductsResponse, error) {
+	imported := map[string]*pb.Product{}
+	for i, p := range req.GetProducts() {
+		if err := validateProduct(p); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "products[%d]: %v", i, err)
+		}
+		if imported[p.GetId()] != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "products[%d]: duplicate ID %s", i, p.GetId())
+		}
+		imported[p.GetId()] = proto.Clone(p).(*pb.Product)
+	}
+
+	resp := &pb.ImportProductsResponse{}
+	err := s.updateCatalog(func(products []*pb.Product) ([]*pb.Product, error) {
+		var out []*pb.Product
+		seen := map[string]bool{}
+		for _, existing := range products {
+			if p := imported[existing.GetId()]; p != nil {
+				out = append(out, p)
+				seen[p.GetId()] = true
+				resp.Updated++
+			} else if req.GetReplace() {
+				resp.Deleted++
+			} else {
+				out = append(out, existing)
+			}
+		}
+		// New products go at the end, in request order.
+		for _, p := range req.GetProducts() {
+			if !seen[p.GetId()] {
+				out = append(out, imported[p.GetId()])
+				resp.Created++
+			}
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("ImportProducts: created=%d updated=%d deleted=%d", resp.Created, resp.Updated, resp.Deleted)
+	return resp, nil
+}
+
+// updateCatalog applies change to a copy of the current products, writes the
+// result to the catalog file and then swaps in a snapshot of it. Errors from
+// change are returned as they are.
+func (s *ProductCatalogService) updateCatalog(change func([]*pb.Product) ([]*pb.Product, error)) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	products, err := change(append([]*pb.Product(nil), s.catalog.Load().products...))
+	if err != nil {
+		return err
+	}
+	snap, err := newProductCatalog(products)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	info, err := writeCatalogFile(s.path, products)
+	if err != nil {
+		log.Printf("Failed to write catalog: %v", err)
+		return status.Errorf(codes.Internal, "failed to save catalog")
+	}
+	snap.modTime, snap.size = info.ModTime(), info.Size()
+	s.catalog.Store(snap)
+	return nil
+}
+
+// writeCatalogFile replaces the catalog file with products, atomically so
+// that readers never see a partial catalog.
+func writeCatalogFile(path string, products []*pb.Product) (os.FileInfo, error) {
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "    "}.Marshal(&pb.ListProductsResponse{Products: products})
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(path, append(data, '\n')); err != nil {
+		return nil, err
+	}
+	return os.Stat(path)
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// newTestCatalog returns a catalog service backed by a file in a temporary
+// directory holding products.
+func newTestCatalog(t *testing.T, adminToken string, products ...*pb.Product) *ProductCatalogService {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "products.json")
+	if _, err := writeCatalogFile(path, products); err != nil {
+		t.Fatal(err)
+	}
+	s := &ProductCatalogService{path: path, adminToken: adminToken}
+	if err := s.loadCatalog(); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestCatalogAdminAuth(t *testing.T) {
+	stderr := log.Writer()
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(stderr)
+
+	for _, tc := range []struct {
+		name       string
+		adminToken string
+		auth       []string // authorization metadata values
+		want       codes.Code
+	}{
+		{"no token", "s3cret", nil, codes.Unauthenticated},
+		{"wrong token", "s3cret", []string{"Bearer nope"}, codes.Unauthenticated},
+		{"not a bearer token", "s3cret", []string{"s3cret"}, codes.Unauthenticated},
+		{"token with a prefix", "s3cret", []string{"Bearer s3cret2"}, codes.Unauthenticated},
+		{"right token", "s3cret", []string{"Bearer s3cret"}, codes.OK},
+		{"right token among others", "s3cret", []string{"Bearer nope", "Bearer s3cret"}, codes.OK},
+		{"admin API disabled", "", []string{"Bearer "}, codes.PermissionDenied},
+		{"admin API disabled without token", "", nil, codes.PermissionDenied},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newTestCatalog(t, tc.adminToken, testProduct("EXISTING"))
+			client := pb.NewProductCatalogServiceClient(dialServer(t,
+				func(srv *grpc.Server) { pb.RegisterProductCatalogServiceServer(srv, s) },
+				grpc.UnaryInterceptor(s.adminAuthInterceptor)))
+
+			ctx := context.Background()
+			for _, v := range tc.auth {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", v)
+			}
+			_, err := client.CreateProduct(ctx, &pb.CreateProductRequest{Product: testProduct("ADDED")})
+			if status.Code(err) != tc.want {
+				t.Errorf("CreateProduct: err = %v, want %v", err, tc.want)
+			}
+			_, err = client.DeleteProduct(ctx, &pb.DeleteProductRequest{Id: "EXISTING"})
+			if status.Code(err) != tc.want {
+				t.Errorf("DeleteProduct: err = %v, want %v", err, tc.want)
+			}
+
+			// Reads never need the token.
+			if _, err := client.GetProduct(context.Background(), &pb.GetProductRequest{Id: "ADDED"}); (err == nil) != (tc.want == codes.OK) {
+				t.Errorf("GetProduct of the created product: err = %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateProduct(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		change  func(p *pb.Product)
+		wantErr string
+	}{
+		{"valid", func(p *pb.Product) {}, ""},
+		{"lowercase id", func(p *pb.Product) { p.Id = "abcd" }, "id"},
+		{"short id", func(p *pb.Product) { p.Id = "ABC" }, "id"},
+		{"id with dash", func(p *pb.Product) { p.Id = "AB-CD" }, "id"},
+		{"blank name", func(p *pb.Product) { p.Name = "  " }, "name"},
+		{"picture outside products", func(p *pb.Product) { p.Picture = "/static/img/logo.png" }, "picture"},
+		{"picture path traversal", func(p *pb.Product) { p.Picture = "/static/img/products/../../secret.jpg" }, "picture"},
+		{"picture not an image", func(p *pb.Product) { p.Picture = "/static/img/products/mug.svg" }, "picture"},
+		{"remote picture", func(p *pb.Product) { p.Picture = "https://example.com/mug.jpg" }, "picture"},
+		{"missing price", func(p *pb.Product) { p.PriceUsd = nil }, "price_usd"},
+		{"zero price", func(p *pb.Product) { p.PriceUsd = usd(0, 0) }, "price_usd"},
+		{"negative price", func(p *pb.Product) { p.PriceUsd = usd(-1, 0) }, "price_usd"},
+		{"euro price", func(p *pb.Product) { p.PriceUsd.CurrencyCode = "EUR" }, "price_usd"},
+		{"invalid nanos", func(p *pb.Product) { p.PriceUsd = usd(1, -5) }, "price_usd"},
+		{"no categories", func(p *pb.Product) { p.Categories = nil }, "category"},
+		{"bad category", func(p *pb.Product) { p.Categories = []string{"Kitchen"} }, "category"},
+		{"negative weight", func(p *pb.Product) { p.WeightGrams = -1 }, "weight"},
+		{"local price in USD", func(p *pb.Product) { p.LocalPrices = []*pb.Money{usd(10, 0)} }, "local price"},
+		{"duplicate local price", func(p *pb.Product) {
+			p.LocalPrices = []*pb.Money{{CurrencyCode: "EUR", Units: 9}, {CurrencyCode: "EUR", Units: 8}}
+		}, "more than one local price"},
+		{"variant with bad SKU", func(p *pb.Product) {
+			p.Variants = []*pb.ProductVariant{{Sku: "x", Attributes: []*pb.VariantAttribute{{Name: "size", Value: "L"}}}}
+		}, "SKU"},
+		{"variant without attributes", func(p *pb.Product) { p.Variants = []*pb.ProductVariant{{Sku: "MUG-L"}} }, "attribute"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := testProduct("MUG1")
+			tc.change(p)
+			err := validateProduct(p)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateProduct: %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("validateProduct: err = %v, want one about %s", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// TestCatalogAdminChangesPersist makes admin changes and checks that a
+// service loading the same file afterwards sees them.
+func TestCatalogAdminChangesPersist(t *testing.T) {
+	stderr := log.Writer()
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(stderr)
+
+	s := newTestCatalog(t, "s3cret", testProduct("KEEP"), testProduct("CHANGE"), testProduct("DROP"))
+	ctx := context.Background()
+
+	changed := testProduct("CHANGE")
+	changed.Name = "Changed"
+	if _, err := s.UpdateProduct(ctx, &pb.UpdateProductRequest{Product: changed}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.DeleteProduct(ctx, &pb.DeleteProductRequest{Id: "DROP"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CreateProduct(ctx, &pb.CreateProductRequest{Product: testProduct("NEW1")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CreateProduct(ctx, &pb.CreateProductRequest{Product: testProduct("KEEP")}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("CreateProduct of an existing ID: err = %v, want AlreadyExists", err)
+	}
+	bad := testProduct("BAD1")
+	bad.Picture = "/etc/passwd"
+	if _, err := s.CreateProduct(ctx, &pb.CreateProductRequest{Product: bad}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateProduct of an invalid product: err = %v, want InvalidArgument", err)
+	}
+	resp, err := s.ImportProducts(ctx, &pb.ImportProductsRequest{Products: []*pb.Product{testProduct("NEW2"), changed}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetCreated() != 1 || resp.GetUpdated() != 1 || resp.GetDeleted() != 0 {
+		t.Errorf("ImportProducts = %v, want 1 created and 1 updated", resp)
+	}
+
+	reloaded := &ProductCatalogService{path: s.path}
+	if err := reloaded.loadCatalog(); err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, p := range reloaded.catalog.Load().products {
+		ids = append(ids, p.GetId())
+	}
+	if got := strings.Join(ids, ","); got != "KEEP,CHANGE,NEW1,NEW2" {
+		t.Errorf("reloaded catalog = %s, want KEEP,CHANGE,NEW1,NEW2", got)
+	}
+	if p, err := reloaded.GetProduct(ctx, &pb.GetProductRequest{Id: "CHANGE"}); err != nil || p.GetName() != "Changed" {
+		t.Errorf("reloaded CHANGE = %v, %v; want it renamed", p, err)
+	}
+}
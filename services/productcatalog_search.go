@@ -0,0 +1,431 @@
+package services
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	// A term in a product's name counts as much as three in its description.
+	searchNameWeight        = 3
+	searchCategoryWeight    = 2
+	searchDescriptionWeight = 1
+
+	// BM25 parameters.
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	// prefixMatchWeight discounts terms matched only by prefix, so that
+	// "bag" ranks "bag" above "bagel".
+	prefixMatchWeight = 0.5
+)
+
+// searchPriceBucketsUSD are the lower bounds of the price facets.
+var searchPriceBucketsUSD = []int64{0, 25, 50, 100}
+
+// stopWords are too common to be worth indexing.
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "for": true, "from": true, "in": true, "is": true,
+	"it": true, "of": true, "on": true, "or": true, "that": true, "the": true,
+	"this": true, "to": true, "with": true, "you": true, "your": true,
+}
+
+// searchIndex is an inverted index over a catalog.
+type searchIndex struct {
+	products []*pb.Product
+	postings map[string][]posting
+	// terms holds the keys of postings, sorted for prefix lookups.
+	terms  []string
+	docLen []float64
+	avgLen float64
+}
+
+// posting is a term's weighted frequency in one product.
+type posting struct {
+	doc int
+	tf  float64
+}
+
+// newSearchIndex indexes the name, categories and description of products.
+func newSearchIndex(products []*pb.Product) *searchIndex {
+	idx := &searchIndex{
+		products: products,
+		postings: map[string][]posting{},
+		docLen:   make([]float64, len(products)),
+	}
+	var total float64
+	for doc, p := range products {
+		tf := map[string]float64{}
+		for _, field := range []struct {
+			text   string
+			weight float64
+		}{
+			{p.GetName(), searchNameWeight},
+			{strings.Join(p.GetCategories(), " "), searchCategoryWeight},
+			{p.GetDescription(), searchDescriptionWeight},
+		} {
+			for _, tok := range tokenize(field.text) {
+				tf[stem(tok)] += field.weight
+				idx.docLen[doc] += field.weight
+			}
+		}
+		for term, f := range tf {
+			idx.postings[term] = append(idx.postings[term], posting{doc: doc, tf: f})
+		}
+		total += idx.docLen[doc]
+	}
+	if len(products) > 0 {
+		idx.avgLen = total / float64(len(products))
+	}
+	idx.terms = make([]string, 0, len(idx.postings))
+	for term := range idx.postings {
+		idx.terms = append(idx.terms, term)
+	}
+	sort.Strings(idx.terms)
+	return idx
+}
+
+// tokenize lowercases s and splits it into words, dropping stop words.
+func tokenize(s string) []string {
+	var out []string
+	for _, w := range strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if !stopWords[w] {
+			out = append(out, w)
+		}
+	}
+	return out
+}
+
+// stem strips common English inflections so that "candles", "candle" and
+// "candled" index as the same term. It is deliberately light: it only has to
+// map a query and the catalog the same way.
+func stem(w string) string {
+	if len(w) <= 3 {
+		return w
+	}
+	switch {
+	case strings.HasSuffix(w, "sses"):
+		w = w[:len(w)-2]
+	case strings.HasSuffix(w, "ies") && len(w) > 4:
+		w = w[:len(w)-3] + "y"
+	case strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss") &&
+		!strings.HasSuffix(w, "us") && !strings.HasSuffix(w, "is"):
+		w = w[:len(w)-1]
+	}
+	for _, suffix := range []string{"ing", "ed"} {
+		if base := strings.TrimSuffix(w, suffix); base != w && len(base) >= 3 && strings.ContainsAny(base, "aeiouy") {
+			w = base
+			// "shopping" -> "shop"
+			if n := len(w); w[n-1] == w[n-2] && !strings.ContainsRune("aeioulsz", rune(w[n-1])) {
+				w = w[:n-1]
+			}
+			break
+		}
+	}
+	if len(w) > 4 && strings.HasSuffix(w, "e") {
+		w = w[:len(w)-1]
+	}
+	return w
+}
+
+// match scores every product matching all words of query. An empty query
+// matches every product with a score of zero.
+func (idx *searchIndex) match(query string) map[int]float64 {
+	words := tokenize(query)
+	scores := make(map[int]float64, len(idx.products))
+	if len(words) == 0 {
+		for doc := range idx.products {
+			scores[doc] = 0
+		}
+		return scores
+	}
+
+	for i, w := range words {
+		wordScores := map[int]float64{}
+		for term, weight := range idx.expand(w) {
+			postings := idx.postings[term]
+			idf := math.Log(1 + (float64(len(idx.products))-float64(len(postings))+0.5)/(float64(len(postings))+0.5))
+			for _, p := range postings {
+				norm := p.tf + bm25K1*(1-bm25B+bm25B*idx.docLen[p.doc]/idx.avgLen)
+				wordScores[p.doc] += weight * idf * p.tf * (bm25K1 + 1) / norm
+			}
+		}
+		if i == 0 {
+			scores = wordScores
+			continue
+		}
+		for doc := range scores {
+			if s, ok := wordScores[doc]; ok {
+				scores[doc] += s
+			} else {
+				delete(scores, doc)
+			}
+		}
+	}
+	return scores
+}
+
+// expand returns the indexed terms a query word matches and how much each
+// counts: its stem fully, and terms it is a prefix of at a discount.
+func (idx *searchIndex) expand(word string) map[string]float64 {
+	terms := map[string]float64{}
+	for _, prefix := range []string{word, stem(word)} {
+		for i := sort.SearchStrings(idx.terms, prefix); i < len(idx.terms) && strings.HasPrefix(idx.terms[i], prefix); i++ {
+			terms[idx.terms[i]] = prefixMatchWeight
+		}
+	}
+	if _, ok := idx.postings[stem(word)]; ok {
+		terms[stem(word)] = 1
+	}
+	return terms
+}
+
+// search runs req against the index.
+func (idx *searchIndex) search(req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	for _, m := range []*pb.Money{req.GetMinPriceUsd(), req.GetMaxPriceUsd()} {
+		if m != nil && (!IsValid(m) || (m.GetCurrencyCode() != "" && m.GetCurrencyCode() != "USD")) {
+			return nil, fmt.Errorf("price bounds must be valid USD amounts")
+		}
+	}
+	pageSize, err := resolvePageSize(req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := searchFingerprint(req, pageSize)
+
+	categories := map[string]bool{}
+	for _, c := range req.GetCategories() {
+		categories[c] = true
+	}
+	inCategory := func(p *pb.Product) bool {
+		if len(categories) == 0 {
+			return true
+		}
+		for _, c := range p.GetCategories() {
+			if categories[c] {
+				return true
+			}
+		}
+		return false
+	}
+	inPriceRange := func(p *pb.Product) bool {
+		return priceInRange(p.GetPriceUsd(), req.GetMinPriceUsd(), req.GetMaxPriceUsd())
+	}
+
+	resp := &pb.SearchProductsResponse{}
+	scores := idx.match(req.GetQuery())
+	categoryCounts := map[string]int32{}
+	priceCounts := make([]int32, len(searchPriceBucketsUSD))
+	var docs []int
+	for doc := range scores {
+		p := idx.products[doc]
+		inCat, inPrice := inCategory(p), inPriceRange(p)
+		if inPrice {
+			for _, c := range p.GetCategories() {
+				categoryCounts[c]++
+			}
+		}
+		if inCat {
+			priceCounts[priceBucket(p.GetPriceUsd())]++
+		}
+		if inCat && inPrice {
+			docs = append(docs, doc)
+		}
+	}
+
+	sort.Slice(docs, func(i, j int) bool {
+		if scores[docs[i]] != scores[docs[j]] {
+			return scores[docs[i]] > scores[docs[j]]
+		}
+		a, b := idx.products[docs[i]], idx.products[docs[j]]
+		if a.GetName() != b.GetName() {
+			return a.GetName() < b.GetName()
+		}
+		return a.GetId() < b.GetId()
+	})
+	offset, err := decodePageToken(req.GetPageToken(), fingerprint, len(docs))
+	if err != nil {
+		return nil, err
+	}
+	resp.TotalResults = int32(len(docs))
+	end := offset + min(pageSize, len(docs)-offset)
+	for _, doc := range docs[offset:end] {
+		resp.Results = append(resp.Results, idx.products[doc])
+	}
+	if end < len(docs) {
+		resp.NextPageToken = encodePageToken(end, fingerprint)
+	}
+
+	names := make([]string, 0, len(categoryCounts))
+	for c := range categoryCounts {
+		names = append(names, c)
+	}
+	sort.Strings(names)
+	for _, c := range names {
+		resp.CategoryFacets = append(resp.CategoryFacets, &pb.CategoryFacet{Category: c, Count: categoryCounts[c]})
+	}
+	for i, lo := range searchPriceBucketsUSD {
+		facet := &pb.PriceFacet{MinUsd: &pb.Money{CurrencyCode: "USD", Units: lo}, Count: priceCounts[i]}
+		if i+1 < len(searchPriceBucketsUSD) {
+			facet.MaxUsd = &pb.Money{CurrencyCode: "USD", Units: searchPriceBucketsUSD[i+1]}
+		}
+		resp.PriceFacets = append(resp.PriceFacets, facet)
+	}
+	return resp, nil
+}
+
+// resolvePageSize applies the default and maximum to a requested page size.
+func resolvePageSize(n int32) (int, error) {
+	switch {
+	case n < 0:
+		return 0, fmt.Errorf("page_size must not be negative")
+	case n == 0:
+		return defaultPageSize, nil
+	default:
+		return min(int(n), maxPageSize), nil
+	}
+}
+
+// compareMoney returns -1, 0 or 1 as a is less than, equal to or greater
+// than b. Currencies are not checked.
+func compareMoney(a, b *pb.Money) int {
+	switch {
+	case a.GetUnits() != b.GetUnits():
+		if a.GetUnits() < b.GetUnits() {
+			return -1
+		}
+		return 1
+	case a.GetNanos() != b.GetNanos():
+		if a.GetNanos() < b.GetNanos() {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// priceInRange reports whether lo <= price < hi. Nil bounds are open.
+func priceInRange(price, lo, hi *pb.Money) bool {
+	return (lo == nil || compareMoney(price, lo) >= 0) && (hi == nil || compareMoney(price, hi) < 0)
+}
+
+// priceBucket returns the index of the price facet holding price.
+func priceBucket(price *pb.Money) int {
+	i := len(searchPriceBucketsUSD) - 1
+	for i > 0 && compareMoney(price, &pb.Money{Units: searchPriceBucketsUSD[i]}) < 0 {
+		i--
+	}
+	return i
+}
+
+// searchFingerprint identifies the query, filters and page size of a
+// search, so that a page token cannot be used with a different search.
+func searchFingerprint(req *pb.SearchProductsRequest, pageSize int) uint64 {
+	categories := append([]string(nil), req.GetCategories()...)
+	sort.Strings(categories)
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%q|%q|%v|%v|%d", req.GetQuery(), categories,
+		moneyKey(req.GetMinPriceUsd()), moneyKey(req.GetMaxPriceUsd()), pageSize)
+	return h.Sum64()
+}
+
+func moneyKey(m *pb.Money) string {
+	if m == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d.%09d", m.GetUnits(), m.GetNanos())
+}
+
+// encodePageToken returns an opaque token for the page starting at offset.
+// The fingerprint ties it to the request it continues.
+func encodePageToken(offset int, fingerprint uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%x", offset, fingerprint)))
+}
+
+// decodePageToken returns the offset in token, or 0 for an empty token.
+// Tokens are not signed, so the offset is only trusted once it is known
+// to be within the total number of results.
+func decodePageToken(token string, fingerprint uint64, total int) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("malformed page_token")
+	}
+	offsetStr, fp, ok := strings.Cut(string(raw), ":")
+	offset, err := strconv.Atoi(offsetStr)
+	if !ok || err != nil || offset < 0 {
+		return 0, fmt.Errorf("malformed page_token")
+	}
+	if fp != strconv.FormatUint(fingerprint, 16) {
+		return 0, fmt.Errorf("page_token is for a different request")
+	}
+	if offset > total {
+		return 0, fmt.Errorf("page_token is past the end of the results")
+	}
+	return offset, nil
+}
+
+// pageProducts filters and sorts products as req asks and returns the
+// requested page.
+func pageProducts(products []*pb.Product, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	pageSize, err := resolvePageSize(req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+	categories := append([]string(nil), req.GetCategories()...)
+	sort.Strings(categories)
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%q|%d|%d", categories, req.GetSortOrder(), pageSize)
+	fingerprint := h.Sum64()
+
+	wanted := map[string]bool{}
+	for _, c := range categories {
+		wanted[c] = true
+	}
+	var matched []*pb.Product
+	for _, p := range products {
+		if len(wanted) == 0 || slices.ContainsFunc(p.GetCategories(), func(c string) bool { return wanted[c] }) {
+			matched = append(matched, p)
+		}
+	}
+
+	switch req.GetSortOrder() {
+	case pb.ProductSortOrder_PRODUCT_SORT_ORDER_NAME:
+		sort.SliceStable(matched, func(i, j int) bool { return matched[i].GetName() < matched[j].GetName() })
+	case pb.ProductSortOrder_PRODUCT_SORT_ORDER_PRICE_ASC:
+		sort.SliceStable(matched, func(i, j int) bool { return compareMoney(matched[i].GetPriceUsd(), matched[j].GetPriceUsd()) < 0 })
+	case pb.ProductSortOrder_PRODUCT_SORT_ORDER_PRICE_DESC:
+		sort.SliceStable(matched, func(i, j int) bool { return compareMoney(matched[i].GetPriceUsd(), matched[j].GetPriceUsd()) > 0 })
+	}
+
+	offset, err := decodePageToken(req.GetPageToken(), fingerprint, len(matched))
+	if err != nil {
+		return nil, err
+	}
+	end := offset + min(pageSize, len(matched)-offset)
+	resp := &pb.ListProductsResponse{
+		Products:  matched[offset:end],
+		TotalSize: int32(len(matched)),
+	}
+	if end < len(matched) {
+		resp.NextPageToken = encodePageToken(end, fingerprint)
+	}
+	return resp, nil
+}
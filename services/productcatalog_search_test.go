@@ -0,0 +1,139 @@
+package services
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"testing"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+func TestSearchPaging(t *testing.T) {
+	var products []*pb.Product
+	for _, id := range []string{"A1", "A2", "A3", "A4", "A5"} {
+		p := testProduct(id)
+		p.Description = "a lamp"
+		products = append(products, p)
+	}
+	idx := newSearchIndex(products)
+
+	req := &pb.SearchProductsRequest{Query: "lamp", PageSize: 2}
+	var got []string
+	for page := 0; ; page++ {
+		resp, err := idx.search(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range resp.GetResults() {
+			got = append(got, p.GetId())
+		}
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+		if page > len(products) {
+			t.Fatal("paging does not end")
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+	if strings.Join(got, ",") != "A1,A2,A3,A4,A5" {
+		t.Errorf("paged results = %v", got)
+	}
+}
+
+func TestSearchRejectsForgedPageToken(t *testing.T) {
+	idx := newSearchIndex([]*pb.Product{testProduct("A1")})
+	req := &pb.SearchProductsRequest{Query: "product"}
+	// Tokens are not signed: anyone can compute the fingerprint.
+	fingerprint := searchFingerprint(req, defaultPageSize)
+	for _, offset := range []int{math.MaxInt - 5, 2} {
+		req.PageToken = encodePageToken(offset, fingerprint)
+		if _, err := idx.search(req); err == nil || !strings.Contains(err.Error(), "past the end") {
+			t.Errorf("search with a token for offset %d: err = %v", offset, err)
+		}
+	}
+}
+
+func TestListProductsPage(t *testing.T) {
+	product := func(id, name string, dollars int64, categories ...string) *pb.Product {
+		p := testProduct(id)
+		p.Name, p.PriceUsd, p.Categories = name, usd(dollars, 0), categories
+		return p
+	}
+	products := []*pb.Product{
+		product("MUG", "Mug", 8, "kitchen"),
+		product("LAMP", "Lamp", 40, "home"),
+		product("JAR", "Jar", 5, "kitchen"),
+		product("SOFA", "Sofa", 300, "home"),
+		product("KNIFE", "Knife", 25, "kitchen"),
+	}
+
+	for _, tc := range []struct {
+		name string
+		req  *pb.ListProductsRequest
+		want string
+	}{
+		{"catalog order", &pb.ListProductsRequest{}, "MUG,LAMP,JAR,SOFA,KNIFE"},
+		{"by name", &pb.ListProductsRequest{SortOrder: pb.ProductSortOrder_PRODUCT_SORT_ORDER_NAME}, "JAR,KNIFE,LAMP,MUG,SOFA"},
+		{"price ascending", &pb.ListProductsRequest{SortOrder: pb.ProductSortOrder_PRODUCT_SORT_ORDER_PRICE_ASC}, "JAR,MUG,KNIFE,LAMP,SOFA"},
+		{"price descending", &pb.ListProductsRequest{SortOrder: pb.ProductSortOrder_PRODUCT_SORT_ORDER_PRICE_DESC}, "SOFA,LAMP,KNIFE,MUG,JAR"},
+		{"category", &pb.ListProductsRequest{Categories: []string{"kitchen"}, SortOrder: pb.ProductSortOrder_PRODUCT_SORT_ORDER_NAME}, "JAR,KNIFE,MUG"},
+		{"unknown category", &pb.ListProductsRequest{Categories: []string{"garden"}}, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Walk the pages two at a time; they must add up to the
+			// whole sorted list.
+			req := tc.req
+			req.PageSize = 2
+			var got []string
+			for page := 0; ; page++ {
+				resp, err := pageProducts(products, req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := strings.Count(tc.want, ",") + 1; tc.want != "" && int(resp.GetTotalSize()) != want {
+					t.Errorf("TotalSize = %d, want %d", resp.GetTotalSize(), want)
+				}
+				for _, p := range resp.GetProducts() {
+					got = append(got, p.GetId())
+				}
+				if resp.GetNextPageToken() == "" {
+					break
+				}
+				if page > len(products) {
+					t.Fatal("paging does not end")
+				}
+				req.PageToken = resp.GetNextPageToken()
+			}
+			if strings.Join(got, ",") != tc.want {
+				t.Errorf("pages = %v, want %s", got, tc.want)
+			}
+		})
+	}
+
+	// A token only continues the listing it came from.
+	first, err := pageProducts(products, &pb.ListProductsRequest{PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = pageProducts(products, &pb.ListProductsRequest{PageSize: 2, PageToken: first.GetNextPageToken(),
+		SortOrder: pb.ProductSortOrder_PRODUCT_SORT_ORDER_NAME})
+	if err == nil || !strings.Contains(err.Error(), "different request") {
+		t.Errorf("token reused with another sort order: err = %v", err)
+	}
+}
+
+func TestListProductsPageRejectsForgedPageToken(t *testing.T) {
+	products := []*pb.Product{testProduct("A1"), testProduct("A2")}
+	// The fingerprint is an unkeyed hash of the request, so a client can
+	// compute it as pageProducts does.
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%q|%d|%d", []string(nil), pb.ProductSortOrder(0), defaultPageSize)
+	for _, offset := range []int{math.MaxInt - 5, 3} {
+		req := &pb.ListProductsRequest{PageToken: encodePageToken(offset, h.Sum64())}
+		if _, err := pageProducts(products, req); err == nil || !strings.Contains(err.Error(), "past the end") {
+			t.Errorf("pageProducts with a token for offset %d: err = %v", offset, err)
+		}
+	}
+}
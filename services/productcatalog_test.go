@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+func testProduct(id string) *pb.Product {
+	return &pb.Product{
+		Id:         id,
+		Name:       "Product " + id,
+		Picture:    "/static/img/products/" + strings.ToLower(id) + ".jpg",
+		PriceUsd:   usd(10, 0),
+		Categories: []string{"test"},
+	}
+}
+
+// TestCatalogReloadWhileServing reloads the catalog file and adds products
+// through the admin RPCs while other goroutines read it. Run it with -race.
+// Readers must always find the products that never change, and once a
+// product has been created no reload may drop it by swapping in a file read
+// from before the change.
+func TestCatalogReloadWhileServing(t *testing.T) {
+	stderr := log.Writer()
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(stderr)
+
+	path := filepath.Join(t.TempDir(), "products.json")
+	if _, err := writeCatalogFile(path, []*pb.Product{testProduct("STABLE1"), testProduct("STABLE2")}); err != nil {
+		t.Fatal(err)
+	}
+	s := &ProductCatalogService{path: path}
+	if err := s.loadCatalog(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	var created atomic.Int32
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	// Readers
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				n := int(created.Load())
+				for _, id := range []string{"STABLE1", "STABLE2"} {
+					if _, err := s.GetProduct(ctx, &pb.GetProductRequest{Id: id}); err != nil {
+						t.Errorf("GetProduct(%s): %v", id, err)
+						return
+					}
+				}
+				for j := 0; j < n; j++ {
+					id := fmt.Sprintf("NEW%03d", j)
+					if _, err := s.GetProduct(ctx, &pb.GetProductRequest{Id: id}); err != nil {
+						t.Errorf("GetProduct(%s) after it was created: %v", id, err)
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	// Reloader, as the file watcher
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if err := s.loadCatalog(); err != nil {
+				t.Errorf("loadCatalog: %v", err)
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < 30; i++ {
+		if _, err := s.CreateProduct(ctx, &pb.CreateProductRequest{Product: testProduct(fmt.Sprintf("NEW%03d", i))}); err != nil {
+			t.Errorf("CreateProduct: %v", err)
+			break
+		}
+		created.Add(1)
+	}
+	close(done)
+	wg.Wait()
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"math/rand"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
@@ -29,6 +31,11 @@ type RecommendationService struct {
 
 	productCatalogSvcAddr string
 	productCatalogSvcConn *grpc.ClientConn
+
+	reviewSvcAddr string
+	reviewSvcConn *grpc.ClientConn
+
+	rec *recommender
 }
 
 // Run starts the server
@@ -37,6 +44,11 @@ func (s *RecommendationService) Run() error {
 
 	mustMapEnv(&s.productCatalogSvcAddr, "PRODUCT_CATALOG_SERVICE_ADDR")
 	mustConnGRPC(ctx, &s.productCatalogSvcConn, s.productCatalogSvcAddr)
+	mustMapEnv(&s.reviewSvcAddr, "REVIEW_SERVICE_ADDR")
+	mustConnGRPC(ctx, &s.reviewSvcConn, s.reviewSvcAddr)
+
+	s.rec = newRecommender(recommenderConfigFromEnv(), s.listProducts, s.listRatings)
+	go s.rec.refreshLoop(ctx)
 
 	opts := []grpc.ServerOption{
 		grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer())),
@@ -52,41 +64,64 @@ func (s *RecommendationService) Run() error {
 	return srv.Serve(lis)
 }
 
-// ListRecommendations provides a list of recommended product IDs based on user and product history
+// ListRecommendations recommends products related to product_ids: those
+// sharing the most categories with them and, once orders have been recorded,
+// those most often bought alongside them. Each comes with a score and a
+// reason naming the input product it was picked for.
 func (s *RecommendationService) ListRecommendations(ctx context.Context, req *pb.ListRecommendationsRequest) (*pb.ListRecommendationsResponse, error) {
 	log.Printf("ListRecommendations request received for user_id = %v, product_ids = %v", req.GetUserId(), req.GetProductIds())
 
-	// Fetch a list of products from the product catalog.
-	catalogProducts, err := pb.NewProductCatalogServiceClient(s.productCatalogSvcConn).ListProducts(ctx, &pb.EmptyUser{UserId: req.GetUserId()})
+	if req.GetMaxResults() < 0 || req.GetMaxPerCategory() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_results and max_per_category must not be negative")
+	}
+
+	snap, err := s.rec.snapshot(ctx)
 	if err != nil {
 		log.Printf("Error fetching catalog products: %v", err)
 		return nil, err
 	}
 
-	// Remove user-provided products from the catalog to avoid recommending them.
-	userProductIDs := req.GetProductIds()
-	userIDs := make(map[string]struct{}, len(userProductIDs))
-	for _, id := range userProductIDs {
-		userIDs[id] = struct{}{}
+	seed := req.GetSeed()
+	if seed == 0 {
+		seed = time.Now().UnixNano()
 	}
-
-	filtered := make([]string, 0, len(catalogProducts.Products))
-	for _, product := range catalogProducts.Products {
-		if _, ok := userIDs[product.Id]; !ok {
-			filtered = append(filtered, product.Id)
-		}
+	recs := s.rec.recommend(snap, recommendOptions{
+		productIDs:     req.GetProductIds(),
+		exclude:        req.GetExcludeProductIds(),
+		context:        req.GetContext(),
+		maxResults:     int(req.GetMaxResults()),
+		maxPerCategory: int(req.GetMaxPerCategory()),
+		seed:           seed,
+	})
+	ids := make([]string, len(recs))
+	for i, rec := range recs {
+		ids[i] = rec.GetProductId()
 	}
+	return &pb.ListRecommendationsResponse{
+		ProductIds:      ids,
+		Recommendations: recs,
+	}, nil
+}
 
-	// Sample from filtered products and return them.
-	rand.Shuffle(len(filtered), func(i, j int) { filtered[i], filtered[j] = filtered[j], filtered[i] })
+// RecordPurchase learns which products were bought together.
+func (s *RecommendationService) RecordPurchase(ctx context.Context, req *pb.RecordPurchaseRequest) (*pb.Empty, error) {
+	log.Printf("RecordPurchase request received for user_id = %v, product_ids = %v", req.GetUserId(), req.GetProductIds())
+	s.rec.recordPurchase(req.GetProductIds())
+	return &pb.Empty{}, nil
+}
 
-	const maxResponses = 5
-	recommended := filtered
-	if len(filtered) > maxResponses {
-		recommended = filtered[:maxResponses]
+func (s *RecommendationService) listProducts(ctx context.Context) ([]*pb.Product, error) {
+	resp, err := pb.NewProductCatalogServiceClient(s.productCatalogSvcConn).ListProducts(ctx, &pb.EmptyUser{})
+	if err != nil {
+		return nil, err
 	}
+	return resp.GetProducts(), nil
+}
 
-	return &pb.ListRecommendationsResponse{
-		ProductIds: recommended,
-	}, nil
+func (s *RecommendationService) listRatings(ctx context.Context) ([]*pb.RatingSummary, error) {
+	resp, err := pb.NewReviewServiceClient(s.reviewSvcConn).GetRatingSummary(ctx, &pb.GetRatingSummaryRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSummaries(), nil
 }
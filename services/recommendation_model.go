@@ -0,0 +1,367 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// recommenderConfig tunes the recommender.
+type recommenderConfig struct {
+	// MaxResults is how many products ListRecommendations returns unless
+	// the request asks for a different number.
+	MaxResults int
+	// CatalogRefresh is how often the catalog snapshot is reloaded.
+	CatalogRefresh time.Duration
+	// CoPurchaseWeight scales the co-purchase signal relative to category
+	// overlap, which scores at most 1 per input product. Zero disables it.
+	CoPurchaseWeight float64
+	// RatingWeight is how far ratings can move a score: a product rated 5
+	// stars scores up to 1+RatingWeight times as much, one rated 1 star down
+	// to 1-RatingWeight. Zero disables it.
+	RatingWeight float64
+}
+
+func recommenderConfigFromEnv() recommenderConfig {
+	return recommenderConfig{
+		MaxResults:       int(envInt64("RECOMMENDATION_MAX_RESULTS", 5)),
+		CatalogRefresh:   envDuration("RECOMMENDATION_CATALOG_REFRESH", 5*time.Minute),
+		CoPurchaseWeight: envFloat("RECOMMENDATION_COPURCHASE_WEIGHT", 0.5),
+		RatingWeight:     envFloat("RECOMMENDATION_RATING_WEIGHT", 0.2),
+	}
+}
+
+// catalogSnapshot is a copy of the product catalog and its ratings as of
+// fetched.
+type catalogSnapshot struct {
+	products []*pb.Product
+	byID     map[string]*pb.Product
+	ratings  map[string]*pb.RatingSummary
+	fetched  time.Time
+}
+
+func newCatalogSnapshot(products []*pb.Product, ratings []*pb.RatingSummary, fetched time.Time) *catalogSnapshot {
+	byID := make(map[string]*pb.Product, len(products))
+	for _, p := range products {
+		byID[p.GetId()] = p
+	}
+	byProduct := make(map[string]*pb.RatingSummary, len(ratings))
+	for _, r := range ratings {
+		byProduct[r.GetProductId()] = r
+	}
+	return &catalogSnapshot{products: products, byID: byID, ratings: byProduct, fetched: fetched}
+}
+
+// ratingPriorReviews is how many average (3 star) reviews every product is
+// assumed to have, so a few reviews cannot swing its rating far.
+const ratingPriorReviews = 5
+
+// ratingSignal returns a product's rating, shrunk towards 3 stars when it has
+// few reviews, scaled to [-1, 1].
+func ratingSignal(r *pb.RatingSummary) float64 {
+	n := float64(r.GetReviewCount())
+	mean := (3*ratingPriorReviews + r.GetAverageRating()*n) / (ratingPriorReviews + n)
+	return (mean - 3) / 2
+}
+
+// recommender scores products by how many categories they share with the
+// products a user is looking at, plus how often they were bought together.
+type recommender struct {
+	cfg          recommenderConfig
+	fetch        func(ctx context.Context) ([]*pb.Product, error)
+	fetchRatings func(ctx context.Context) ([]*pb.RatingSummary, error)
+
+	mu      sync.RWMutex
+	catalog *catalogSnapshot
+	// coPurchases[a][b] counts completed orders containing both a and b.
+	coPurchases map[string]map[string]int
+}
+
+func newRecommender(cfg recommenderConfig, fetch func(ctx context.Context) ([]*pb.Product, error),
+	fetchRatings func(ctx context.Context) ([]*pb.RatingSummary, error)) *recommender {
+	return &recommender{
+		cfg:          cfg,
+		fetch:        fetch,
+		fetchRatings: fetchRatings,
+		coPurchases:  map[string]map[string]int{},
+	}
+}
+
+// refreshLoop reloads the catalog snapshot every CatalogRefresh until ctx is
+// done. A failed reload keeps serving the previous snapshot. Ratings are
+// optional: if they cannot be fetched the previous ones are kept.
+func (r *recommender) refreshLoop(ctx context.Context) {
+	if _, err := r.refresh(ctx); err != nil {
+		log.Printf("failed to load catalog snapshot: %v", err)
+	}
+	t := time.NewTicker(r.cfg.CatalogRefresh)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if _, err := r.refresh(ctx); err != nil {
+				log.Printf("failed to refresh catalog snapshot: %v", err)
+			}
+		}
+	}
+}
+
+func (r *recommender) refresh(ctx context.Context) (*catalogSnapshot, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	products, err := r.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ratings, err := r.fetchRatings(ctx)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		log.Printf("failed to fetch ratings, keeping previous ones: %v", err)
+		ratings = nil
+		if r.catalog != nil {
+			for _, rating := range r.catalog.ratings {
+				ratings = append(ratings, rating)
+			}
+		}
+	}
+	r.catalog = newCatalogSnapshot(products, ratings, time.Now())
+	return r.catalog, nil
+}
+
+// snapshot returns the cached catalog, loading it if no load has succeeded
+// yet.
+func (r *recommender) snapshot(ctx context.Context) (*catalogSnapshot, error) {
+	r.mu.RLock()
+	snap := r.catalog
+	r.mu.RUnlock()
+	if snap != nil {
+		return snap, nil
+	}
+	return r.refresh(ctx)
+}
+
+// recordPurchase counts every pair of distinct products in an order.
+// Products missing from the catalog snapshot are ignored.
+func (r *recommender) recordPurchase(productIDs []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ids []string
+	seen := map[string]bool{}
+	for _, id := range productIDs {
+		if seen[id] || (r.catalog != nil && r.catalog.byID[id] == nil) {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	for _, a := range ids {
+		for _, b := range ids {
+			if a == b {
+				continue
+			}
+			if r.coPurchases[a] == nil {
+				r.coPurchases[a] = map[string]int{}
+			}
+			r.coPurchases[a][b]++
+		}
+	}
+}
+
+// recommendOptions are the parsed fields of a ListRecommendationsRequest.
+type recommendOptions struct {
+	productIDs     []string
+	exclude        []string
+	context        pb.RecommendationContext
+	maxResults     int
+	maxPerCategory int
+	seed           int64
+}
+
+// candidateScore is a candidate's score and the input product that
+// contributed most to it.
+type candidateScore struct {
+	score      float64
+	because    string
+	best       float64
+	coPurchase bool
+}
+
+// recommend returns up to opts.maxResults products that are neither input
+// nor excluded, best first. Products with equal scores are ordered by a
+// shuffle seeded with opts.seed.
+func (r *recommender) recommend(snap *catalogSnapshot, opts recommendOptions) []*pb.Recommendation {
+	skip := map[string]bool{}
+	for _, id := range opts.productIDs {
+		skip[id] = true
+	}
+	for _, id := range opts.exclude {
+		skip[id] = true
+	}
+
+	candidates := make([]string, 0, len(snap.products))
+	for _, p := range snap.products {
+		if !skip[p.GetId()] {
+			candidates = append(candidates, p.GetId())
+		}
+	}
+	rand.New(rand.NewSource(opts.seed)).Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+
+	scores := r.scores(snap, opts, candidates)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[candidates[i]].score > scores[candidates[j]].score
+	})
+
+	maxResults := opts.maxResults
+	if maxResults == 0 {
+		maxResults = r.cfg.MaxResults
+	}
+	perCategory := map[string]int{}
+	var recs []*pb.Recommendation
+	for _, id := range candidates {
+		if len(recs) == maxResults {
+			break
+		}
+		categories := snap.byID[id].GetCategories()
+		if opts.maxPerCategory > 0 && categoryFull(categories, perCategory, opts.maxPerCategory) {
+			continue
+		}
+		for _, c := range categories {
+			perCategory[c]++
+		}
+		sc := scores[id]
+		recs = append(recs, &pb.Recommendation{
+			ProductId:        id,
+			Score:            sc.score,
+			Reason:           recommendationReason(opts.context, snap.byID[sc.because].GetName(), sc.coPurchase),
+			BecauseProductId: sc.because,
+		})
+	}
+	return recs
+}
+
+// categoryFull reports whether any of categories already has limit
+// recommendations.
+func categoryFull(categories []string, counts map[string]int, limit int) bool {
+	for _, c := range categories {
+		if counts[c] >= limit {
+			return true
+		}
+	}
+	return false
+}
+
+// scores sums, over the input products, the Jaccard similarity of each
+// candidate's categories with the input product's, plus the weighted share
+// of the input product's co-purchases that included the candidate. In the
+// cart and post-purchase contexts co-purchases count double, favouring
+// products that go with the input over alternatives to it. The sum is then
+// scaled up or down by the candidate's rating.
+func (r *recommender) scores(snap *catalogSnapshot, opts recommendOptions, candidates []string) map[string]*candidateScore {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	weight := r.cfg.CoPurchaseWeight
+	if opts.context == pb.RecommendationContext_RECOMMENDATION_CONTEXT_CART ||
+		opts.context == pb.RecommendationContext_RECOMMENDATION_CONTEXT_POST_PURCHASE {
+		weight *= 2
+	}
+
+	scores := make(map[string]*candidateScore, len(candidates))
+	for _, c := range candidates {
+		scores[c] = &candidateScore{}
+	}
+	seen := map[string]bool{}
+	for _, id := range opts.productIDs {
+		p := snap.byID[id]
+		if p == nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		bought := r.coPurchases[id]
+		var total int
+		for _, n := range bought {
+			total += n
+		}
+		for _, c := range candidates {
+			category := jaccard(p.GetCategories(), snap.byID[c].GetCategories())
+			var coPurchase float64
+			if weight > 0 && total > 0 {
+				coPurchase = weight * float64(bought[c]) / float64(total)
+			}
+			sc := scores[c]
+			sc.score += category + coPurchase
+			if category+coPurchase > sc.best {
+				sc.best = category + coPurchase
+				sc.because = id
+				sc.coPurchase = coPurchase > category
+			}
+		}
+	}
+	if r.cfg.RatingWeight > 0 {
+		for c, sc := range scores {
+			if rating := snap.ratings[c]; rating != nil {
+				sc.score *= 1 + r.cfg.RatingWeight*ratingSignal(rating)
+			}
+		}
+	}
+	return scores
+}
+
+// recommendationReason explains a recommendation made because of the
+// product named because.
+func recommendationReason(context pb.RecommendationContext, because string, coPurchase bool) string {
+	switch {
+	case because == "":
+		return ""
+	case coPurchase:
+		return fmt.Sprintf("Customers who bought %s also bought this", because)
+	}
+	switch context {
+	case pb.RecommendationContext_RECOMMENDATION_CONTEXT_PRODUCT:
+		return fmt.Sprintf("Similar to %s", because)
+	case pb.RecommendationContext_RECOMMENDATION_CONTEXT_CART:
+		return fmt.Sprintf("Goes with %s in your cart", because)
+	case pb.RecommendationContext_RECOMMENDATION_CONTEXT_POST_PURCHASE:
+		return fmt.Sprintf("Because you bought %s", because)
+	default:
+		return fmt.Sprintf("Because you viewed %s", because)
+	}
+}
+
+// jaccard returns |a ∩ b| / |a ∪ b|, or 0 if both are empty.
+func jaccard(a, b []string) float64 {
+	set := make(map[string]bool, len(a))
+	for _, s := range a {
+		set[s] = true
+	}
+	union := len(set)
+	var shared int
+	seen := map[string]bool{}
+	for _, s := range b {
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		if set[s] {
+			shared++
+		} else {
+			union++
+		}
+	}
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// fakeRecommendations records the requests it is sent and recommends
+// nothing.
+type fakeRecommendations struct {
+	pb.UnimplementedRecommendationServiceServer
+	mu   sync.Mutex
+	reqs []*pb.ListRecommendationsRequest
+}
+
+func (f *fakeRecommendations) ListRecommendations(_ context.Context, req *pb.ListRecommendationsRequest) (*pb.ListRecommendationsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reqs = append(f.reqs, req)
+	return &pb.ListRecommendationsResponse{}, nil
+}
+
+func (f *fakeRecommendations) last() *pb.ListRecommendationsRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.reqs[len(f.reqs)-1]
+}
+
+// TestPageRecommendationOptions checks what the product and cart pages ask
+// the recommendation service for.
+func TestPageRecommendationOptions(t *testing.T) {
+	catalog := &fakeCatalog{products: []*pb.Product{
+		{Id: "SUNGLASSES", Name: "Sunglasses", PriceUsd: usd(19, 990000000), Categories: []string{"accessories"}},
+		{Id: "WATCH", Name: "Watch", PriceUsd: usd(109, 990000000), Categories: []string{"accessories"}},
+	}}
+	recs := &fakeRecommendations{}
+	none := dialServer(t, func(*grpc.Server) {})
+	fe := &frontendServer{
+		productCatalogSvcConn: dialServer(t, func(s *grpc.Server) { pb.RegisterProductCatalogServiceServer(s, catalog) }),
+		cartSvcConn:           dialServer(t, func(s *grpc.Server) { pb.RegisterCartServiceServer(s, newFakeCart()) }),
+		currencySvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterCurrencyServiceServer(s, fakeCurrency{}) }),
+		shippingSvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterShippingServiceServer(s, fakeShipping{}) }),
+		recommendationSvcConn: dialServer(t, func(s *grpc.Server) { pb.RegisterRecommendationServiceServer(s, recs) }),
+		reviewSvcConn:         none,
+		adSvcConn:             none,
+	}
+	srv := httptest.NewServer(fe.handler())
+	defer srv.Close()
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Jar: jar}
+	visit := func(resp *http.Response, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status %d\n%s", resp.StatusCode, body)
+		}
+	}
+
+	// Adding to the cart shows the cart page.
+	visit(client.PostForm(srv.URL+"/cart", url.Values{"product_id": {"SUNGLASSES"}, "quantity": {"1"}}))
+	want := &pb.ListRecommendationsRequest{
+		ProductIds:     []string{"SUNGLASSES"},
+		Context:        pb.RecommendationContext_RECOMMENDATION_CONTEXT_CART,
+		MaxResults:     maxRecommendations,
+		MaxPerCategory: 2,
+	}
+	got := recs.last()
+	want.UserId = got.GetUserId()
+	if got.GetUserId() == "" || !proto.Equal(got, want) {
+		t.Errorf("cart page asked for %v, want %v", got, want)
+	}
+
+	visit(client.Get(srv.URL + "/product/WATCH"))
+	want = &pb.ListRecommendationsRequest{
+		UserId:            got.GetUserId(),
+		ProductIds:        []string{"WATCH"},
+		ExcludeProductIds: []string{"SUNGLASSES"},
+		Context:           pb.RecommendationContext_RECOMMENDATION_CONTEXT_PRODUCT,
+		MaxResults:        maxRecommendations,
+		MaxPerCategory:    2,
+	}
+	if got := recs.last(); !proto.Equal(got, want) {
+		t.Errorf("product page asked for %v, want %v", got, want)
+	}
+}
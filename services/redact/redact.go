@@ -0,0 +1,183 @@
+// Package redact masks cardholder data and personal details before they are
+// written to logs or attached to traces.
+package redact
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const mask = "[REDACTED]"
+
+// CardNumber keeps at most the last four digits of a card number. Numbers
+// too short to be a real PAN are masked entirely.
+func CardNumber(number string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, number)
+	if len(digits) < 12 {
+		return "****"
+	}
+	return "****" + digits[len(digits)-4:]
+}
+
+// Email keeps the first character of the local part and the domain.
+func Email(email string) string {
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || local == "" {
+		return mask
+	}
+	first, _ := utf8.DecodeRuneInString(local)
+	return string(first) + "***@" + domain
+}
+
+// Street masks a street address entirely.
+func Street(string) string {
+	return mask
+}
+
+// Secret masks a credential such as an unsubscribe token entirely.
+func Secret(string) string {
+	return mask
+}
+
+// rawURL is URL for a URL that has not been parsed.
+func rawURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return mask
+	}
+	return URL(u)
+}
+
+// sensitiveFields maps proto field names to how their values are masked.
+// Fields not listed are left alone.
+var sensitiveFields = map[protoreflect.Name]func(string) string{
+	"credit_card_number":     CardNumber,
+	"credit_card_cvv_digits": Secret,
+	"email":                  Email,
+	"street_address":         Street,
+	"unsubscribe_token":      Secret,
+	"unsubscribe_url":        rawURL,
+}
+
+// zeroedFields are numeric card fields that are cleared outright.
+var zeroedFields = map[protoreflect.Name]bool{
+	"credit_card_cvv":              true,
+	"credit_card_expiration_year":  true,
+	"credit_card_expiration_month": true,
+}
+
+// message returns a copy of m with card data, email addresses, street
+// addresses and unsubscribe tokens masked, at any depth. m itself is not
+// modified.
+func message(m proto.Message) proto.Message {
+	if m == nil {
+		return nil
+	}
+	c := proto.Clone(m)
+	redactMessage(c.ProtoReflect())
+	return c
+}
+
+func redactMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case zeroedFields[fd.Name()]:
+			m.Clear(fd)
+		case sensitiveFields[fd.Name()] != nil && fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap():
+			m.Set(fd, protoreflect.ValueOfString(sensitiveFields[fd.Name()](v.String())))
+		case fd.IsList() && fd.Message() != nil:
+			l := v.List()
+			for i := 0; i < l.Len(); i++ {
+				redactMessage(l.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				redactMessage(mv.Message())
+				return true
+			})
+		case fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			redactMessage(v.Message())
+		}
+		return true
+	})
+}
+
+// toJSON renders a redacted copy of m as single-line JSON for logs and tags.
+func toJSON(m proto.Message) string {
+	b, err := protojson.Marshal(message(m))
+	if err != nil {
+		return fmt.Sprintf("<unprintable %T>", m)
+	}
+	return string(b)
+}
+
+// Logf is log.Printf with every proto.Message argument redacted first.
+func Logf(format string, args ...interface{}) {
+	for i, a := range args {
+		if m, ok := a.(proto.Message); ok {
+			args[i] = toJSON(m)
+		}
+	}
+	log.Output(2, fmt.Sprintf(format, args...))
+}
+
+// sensitiveParams are query or form parameters masked by URL.
+var sensitiveParams = map[string]func(string) string{
+	"credit_card_number":           CardNumber,
+	"credit_card_cvv":              func(string) string { return mask },
+	"credit_card_expiration_month": func(string) string { return mask },
+	"credit_card_expiration_year":  func(string) string { return mask },
+	"email":                        Email,
+	"street_address":               Street,
+	"token":                        Secret,
+}
+
+// URL returns u as a string with sensitive query parameters masked.
+func URL(u *url.URL) string {
+	q := u.Query()
+	if len(q) == 0 {
+		return u.String()
+	}
+	for k, vs := range q {
+		if f, ok := sensitiveParams[k]; ok {
+			for i, v := range vs {
+				vs[i] = f(v)
+			}
+		}
+	}
+	c := *u
+	c.RawQuery = q.Encode()
+	return c.String()
+}
+
+// UnaryServerInterceptor tags the active span with a redacted copy of the
+// request and logs it if the handler fails. Chain it after the tracing
+// interceptor so that the span exists.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		m, isProto := req.(proto.Message)
+		if span := opentracing.SpanFromContext(ctx); span != nil && isProto {
+			span.SetTag("grpc.request", toJSON(m))
+		}
+		resp, err := handler(ctx, req)
+		if err != nil && isProto {
+			log.Printf("%s failed: %v; request=%s", info.FullMethod, err, toJSON(m))
+		}
+		return resp, err
+	}
+}
@@ -0,0 +1,110 @@
+package redact
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// captureLog returns what the standard logger writes until the test ends.
+func captureLog(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	out, flags := log.Writer(), log.Flags()
+	log.SetOutput(&buf)
+	t.Cleanup(func() {
+		log.SetOutput(out)
+		log.SetFlags(flags)
+	})
+	return &buf
+}
+
+// order is a checkout request carrying every kind of sensitive data.
+func order() *pb.PlaceOrderRequest {
+	return &pb.PlaceOrderRequest{
+		UserId:       "user-1",
+		UserCurrency: "USD",
+		Email:        "someone@example.com",
+		Address:      &pb.Address{StreetAddress: "1600 Amphitheatre Parkway", City: "Mountain View", Country: "US"},
+		CreditCard: &pb.CreditCardInfo{
+			CreditCardNumber:          "4432-8015-6152-0454",
+			CreditCardCvv:             672,
+			CreditCardCvvDigits:       "672",
+			CreditCardExpirationMonth: 1,
+			CreditCardExpirationYear:  2030,
+		},
+	}
+}
+
+// assertRedacted fails if out contains any of order's sensitive values.
+func assertRedacted(t *testing.T, out string) {
+	t.Helper()
+	for _, secret := range []string{"4432", "8015", "6152", "672", "2030", "someone@", "Amphitheatre"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("log contains %q:\n%s", secret, out)
+		}
+	}
+	for _, kept := range []string{"****0454", "s***@example.com", "Mountain View", "user-1"} {
+		if !strings.Contains(out, kept) {
+			t.Errorf("log lacks %q:\n%s", kept, out)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	out := captureLog(t)
+	req := order()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New("card declined")
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/hipstershop.CheckoutService/PlaceOrder"}
+	if _, err := UnaryServerInterceptor()(context.Background(), req, info, handler); err == nil {
+		t.Fatal("interceptor swallowed the handler's error")
+	}
+	assertRedacted(t, out.String())
+	if !strings.Contains(out.String(), "PlaceOrder failed: card declined") {
+		t.Errorf("failure not logged:\n%s", out)
+	}
+	if req.GetCreditCard().GetCreditCardNumber() != "4432-8015-6152-0454" {
+		t.Error("interceptor modified the request")
+	}
+}
+
+func TestLogf(t *testing.T) {
+	out := captureLog(t)
+	Logf("request: %v", order())
+	assertRedacted(t, out.String())
+}
+
+func TestEmail(t *testing.T) {
+	for _, tc := range []struct{ in, want string }{
+		{"someone@example.com", "s***@example.com"},
+		{"émile@example.fr", "é***@example.fr"},
+		{"名前@example.jp", "名***@example.jp"},
+		{"@example.com", mask},
+		{"not an email", mask},
+	} {
+		if got := Email(tc.in); got != tc.want {
+			t.Errorf("Email(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestCardNumber(t *testing.T) {
+	for _, tc := range []struct{ in, want string }{
+		{"4432801561520454", "****0454"},
+		{"4432 8015 6152 0454", "****0454"},
+		{"12345", "****"},
+		{"", "****"},
+	} {
+		if got := CardNumber(tc.in); got != tc.want {
+			t.Errorf("CardNumber(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
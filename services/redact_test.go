@@ -0,0 +1,74 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+	"github.com/deskchen/online-boutique-grpc/services/redact"
+)
+
+// TestPaymentLogsRedactCards places orders, one paid and one declined,
+// through CheckoutService and a real PaymentService, both behind the
+// redacting interceptor as in production, and checks that no card number
+// reaches the log.
+func TestPaymentLogsRedactCards(t *testing.T) {
+	l, err := newLedger(memoryLedgerStore{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payment := &PaymentService{processor: newFakeGateway(fakeGatewayConfig{Seed: 1}), ledger: l}
+	interceptor := grpc.UnaryInterceptor(redact.UnaryServerInterceptor())
+
+	catalog := &fakeCatalog{products: []*pb.Product{{Id: "SUNGLASSES", Name: "Sunglasses", PriceUsd: usd(19, 990000000)}}}
+	cart := newFakeCart()
+	none := dialServer(t, func(*grpc.Server) {})
+	cs := &CheckoutService{
+		productCatalogSvcConn: dialServer(t, func(s *grpc.Server) { pb.RegisterProductCatalogServiceServer(s, catalog) }),
+		cartSvcConn:           dialServer(t, func(s *grpc.Server) { pb.RegisterCartServiceServer(s, cart) }),
+		currencySvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterCurrencyServiceServer(s, fakeCurrency{}) }),
+		shippingSvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterShippingServiceServer(s, &checkoutShipping{}) }),
+		paymentSvcConn:        dialServer(t, func(s *grpc.Server) { pb.RegisterPaymentServiceServer(s, payment) }, interceptor),
+		emailSvcConn:          none,
+		recommendationSvcConn: none,
+	}
+	checkout := pb.NewCheckoutServiceClient(dialServer(t, func(s *grpc.Server) { pb.RegisterCheckoutServiceServer(s, cs) }, interceptor))
+
+	var out bytes.Buffer
+	stderr := log.Writer()
+	defer log.SetOutput(stderr)
+	log.SetOutput(&out)
+
+	for _, number := range []string{"4432801561520454", cardDecline} {
+		cart.carts["user"] = []*pb.CartItem{{ProductId: "SUNGLASSES", Quantity: 1}}
+		checkout.PlaceOrder(context.Background(), &pb.PlaceOrderRequest{
+			UserId:       "user",
+			UserCurrency: "USD",
+			Email:        "someone@example.com",
+			Address:      quoteAddress,
+			CreditCard: &pb.CreditCardInfo{
+				CreditCardNumber:          number,
+				CreditCardCvvDigits:       "672",
+				CreditCardExpirationMonth: 1,
+				CreditCardExpirationYear:  cardExpirationYear,
+			},
+		})
+	}
+	log.SetOutput(stderr)
+
+	for _, want := range []string{"Authorize request received", "Authorization failed", "PlaceOrder failed", "****0454", "****0002"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("log lacks %q:\n%s", want, &out)
+		}
+	}
+	for _, secret := range []string{"4432801561520454", cardDecline, "someone@", "1600 Amphitheatre"} {
+		if strings.Contains(out.String(), secret) {
+			t.Errorf("log contains %q:\n%s", secret, &out)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	"github.com/opentracing/opentracing-go"
+)
+
+const (
+	maxReviewAuthorLength = 60
+	maxReviewTitleLength  = 120
+	maxReviewBodyLength   = 4000
+)
+
+// NewReviewService returns a new server for the ReviewService
+func NewReviewService(port int) *ReviewService {
+	// Reviews are kept in memory unless REVIEW_FILE names a journal to
+	// persist them to.
+	var store reviewStore = memoryReviewStore{}
+	if path := os.Getenv("REVIEW_FILE"); path != "" {
+		store = newFileReviewStore(path)
+	}
+	reviews, err := store.Load()
+	if err != nil {
+		log.Fatalf("Failed to load reviews: %v", err)
+	}
+
+	s := &ReviewService{
+		port:      port,
+		store:     store,
+		now:       time.Now,
+		byProduct: map[string]*productReviews{},
+	}
+	for _, r := range reviews {
+		if r.GetRating() < 1 || r.GetRating() > 5 {
+			log.Fatalf("Failed to load reviews: review %s has rating %d", r.GetId(), r.GetRating())
+		}
+		s.reviewsOf(r.GetProductId()).add(r)
+	}
+	log.Printf("Loaded %d reviews", len(reviews))
+	return s
+}
+
+// ReviewService implements the ReviewService
+type ReviewService struct {
+	port int
+	pb.ReviewServiceServer
+
+	productCatalogSvcAddr string
+	productCatalogSvcConn *grpc.ClientConn
+
+	store reviewStore
+	now   func() time.Time
+
+	mu        sync.RWMutex
+	byProduct map[string]*productReviews
+}
+
+// productReviews holds one product's reviews, oldest first, and running
+// rating totals.
+type productReviews struct {
+	reviews  []*pb.Review
+	reviewed map[string]bool // by user ID
+	counts   [5]int32        // by rating - 1
+	sum      int64
+}
+
+func (pr *productReviews) add(r *pb.Review) {
+	pr.reviews = append(pr.reviews, r)
+	pr.reviewed[r.GetUserId()] = true
+	pr.counts[r.GetRating()-1]++
+	pr.sum += int64(r.GetRating())
+}
+
+// reviewsOf returns productID's reviews, creating an empty entry if there are
+// none. s.mu must be held for writing, or not yet shared.
+func (s *ReviewService) reviewsOf(productID string) *productReviews {
+	pr := s.byProduct[productID]
+	if pr == nil {
+		pr = &productReviews{reviewed: map[string]bool{}}
+		s.byProduct[productID] = pr
+	}
+	return pr
+}
+
+// Run starts the server
+func (s *ReviewService) Run() error {
+	ctx := context.Background()
+
+	mustMapEnv(&s.productCatalogSvcAddr, "PRODUCT_CATALOG_SERVICE_ADDR")
+	mustConnGRPC(ctx, &s.productCatalogSvcConn, s.productCatalogSvcAddr)
+
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer())),
+	}
+	srv := grpc.NewServer(opts...)
+	pb.RegisterReviewServiceServer(srv, s)
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	log.Printf("ReviewService running at port: %d", s.port)
+	return srv.Serve(lis)
+}
+
+// AddReview adds a review of a product in the catalog
+func (s *ReviewService) AddReview(ctx context.Context, req *pb.AddReviewRequest) (*pb.Review, error) {
+	log.Printf("AddReview request for user_id = %v, product_id = %v, rating = %v", req.GetUserId(), req.GetProductId(), req.GetRating())
+
+	r := &pb.Review{
+		ProductId:  req.GetProductId(),
+		UserId:     req.GetUserId(),
+		AuthorName: strings.TrimSpace(req.GetAuthorName()),
+		Rating:     req.GetRating(),
+		Title:      strings.TrimSpace(req.GetTitle()),
+		Body:       strings.TrimSpace(req.GetBody()),
+	}
+	switch {
+	case r.UserId == "" || r.ProductId == "":
+		return nil, status.Errorf(codes.InvalidArgument, "user_id and product_id are required")
+	case r.Rating < 1 || r.Rating > 5:
+		return nil, status.Errorf(codes.InvalidArgument, "rating must be from 1 to 5")
+	case len(r.AuthorName) > maxReviewAuthorLength:
+		return nil, status.Errorf(codes.InvalidArgument, "author_name must be at most %d characters", maxReviewAuthorLength)
+	case len(r.Title) > maxReviewTitleLength:
+		return nil, status.Errorf(codes.InvalidArgument, "title must be at most %d characters", maxReviewTitleLength)
+	case len(r.Body) > maxReviewBodyLength:
+		return nil, status.Errorf(codes.InvalidArgument, "body must be at most %d characters", maxReviewBodyLength)
+	}
+
+	if _, err := pb.NewProductCatalogServiceClient(s.productCatalogSvcConn).
+		GetProduct(ctx, &pb.GetProductRequest{Id: r.ProductId}); status.Code(err) == codes.NotFound {
+		return nil, status.Errorf(codes.NotFound, "no product with ID %s", r.ProductId)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to look up product %s: %v", r.ProductId, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pr := s.reviewsOf(r.ProductId)
+	if pr.reviewed[r.UserId] {
+		return nil, status.Errorf(codes.AlreadyExists, "user %s already reviewed product %s", r.UserId, r.ProductId)
+	}
+	r.Id = uuid.NewString()
+	r.CreatedUnix = s.now().Unix()
+	if err := s.store.Save(r); err != nil {
+		log.Printf("Failed to save review: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to save review")
+	}
+	pr.add(r)
+	return r, nil
+}
+
+// ListReviews lists a product's reviews, newest first. Page tokens count
+// from the oldest review, so reviews added between pages do not shift later
+// pages.
+func (s *ReviewService) ListReviews(ctx context.Context, req *pb.ListReviewsRequest) (*pb.ListReviewsResponse, error) {
+	log.Printf("ListReviews request for product_id = %v", req.GetProductId())
+
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	pageSize, err := resolvePageSize(req.GetPageSize())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "reviews|%q", req.GetProductId())
+	fingerprint := h.Sum64()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var reviews []*pb.Review
+	if pr := s.byProduct[req.GetProductId()]; pr != nil {
+		reviews = pr.reviews
+	}
+
+	end := len(reviews)
+	if req.GetPageToken() != "" {
+		if end, err = decodePageToken(req.GetPageToken(), fingerprint, len(reviews)); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	start := max(end-pageSize, 0)
+
+	resp := &pb.ListReviewsResponse{TotalSize: int32(len(reviews))}
+	for i := end - 1; i >= start; i-- {
+		resp.Reviews = append(resp.Reviews, reviews[i])
+	}
+	if start > 0 {
+		resp.NextPageToken = encodePageToken(start, fingerprint)
+	}
+	return resp, nil
+}
+
+// GetRatingSummary returns rating counts and averages
+func (s *ReviewService) GetRatingSummary(ctx context.Context, req *pb.GetRatingSummaryRequest) (*pb.GetRatingSummaryResponse, error) {
+	log.Printf("GetRatingSummary request for product_ids = %v", req.GetProductIds())
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := req.GetProductIds()
+	if len(ids) == 0 {
+		ids = make([]string, 0, len(s.byProduct))
+		for id, pr := range s.byProduct {
+			if len(pr.reviews) > 0 {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+	}
+	resp := &pb.GetRatingSummaryResponse{}
+	for _, id := range ids {
+		summary := &pb.RatingSummary{ProductId: id, RatingCounts: make([]int32, 5)}
+		if pr := s.byProduct[id]; pr != nil && len(pr.reviews) > 0 {
+			copy(summary.RatingCounts, pr.counts[:])
+			summary.ReviewCount = int32(len(pr.reviews))
+			summary.AverageRating = float64(pr.sum) / float64(len(pr.reviews))
+		}
+		resp.Summaries = append(resp.Summaries, summary)
+	}
+	return resp, nil
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// reviewStore persists reviews. Reviews are never changed once added, so
+// Save is called once per review; Load returns them in the order saved.
+type reviewStore interface {
+	Load() ([]*pb.Review, error)
+	Save(r *pb.Review) error
+}
+
+// memoryReviewStore keeps nothing beyond the service's own index.
+type memoryReviewStore struct{}
+
+func (memoryReviewStore) Load() ([]*pb.Review, error) { return nil, nil }
+func (memoryReviewStore) Save(*pb.Review) error       { return nil }
+
+// fileReviewStore appends every review to a JSON-lines file.
+type fileReviewStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+func newFileReviewStore(path string) *fileReviewStore {
+	return &fileReviewStore{path: path}
+}
+
+func (s *fileReviewStore) Load() ([]*pb.Review, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var reviews []*pb.Review
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; sc.Scan(); line++ {
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		r := &pb.Review{}
+		if err := protojson.Unmarshal(sc.Bytes(), r); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", s.path, line, err)
+		}
+		reviews = append(reviews, r)
+	}
+	return reviews, sc.Err()
+}
+
+func (s *fileReviewStore) Save(r *pb.Review) error {
+	line, err := protojson.MarshalOptions{Multiline: false}.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
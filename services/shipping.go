@@ -4,22 +4,56 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"math"
-	"math/rand"
 	"net"
+	"os"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+	"github.com/deskchen/online-boutique-grpc/services/redact"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/opentracing/opentracing-go"
 )
 
 // NewShippingService returns a new server for the ShippingService
 func NewShippingService(port int) *ShippingService {
+	ratesData, err := os.ReadFile(shippingRatesFilePath)
+	if err != nil {
+		log.Fatalf("Failed to read file: %v", err)
+	}
+	rates, err := newShippingRateEngine(ratesData)
+	if err != nil {
+		log.Fatalf("Failed to parse shipping rates: %v", err)
+	}
+
+	fulfillmentData, err := os.ReadFile(fulfillmentFilePath)
+	if err != nil {
+		log.Fatalf("Failed to read file: %v", err)
+	}
+	fulfillment, err := newFulfillmentPlanner(fulfillmentData)
+	if err != nil {
+		log.Fatalf("Failed to parse fulfillment data: %v", err)
+	}
+
+	maxShipments := envInt64("SHIPMENT_MAX_STORED", defaultMaxShipments)
+	if maxShipments < 1 {
+		log.Fatalf("SHIPMENT_MAX_STORED must be positive, got %d", maxShipments)
+	}
+
 	return &ShippingService{
-		name: "shipping-service",
-		port: port,
+		name:        "shipping-service",
+		port:        port,
+		rates:       rates,
+		fulfillment: fulfillment,
+		shipments: newShipmentStore(
+			envDuration("SHIPMENT_RETENTION", defaultShipmentRetention),
+			int(maxShipments),
+		),
+		now: time.Now,
 	}
 }
 
@@ -28,12 +62,31 @@ type ShippingService struct {
 	name string
 	port int
 	pb.ShippingServiceServer
+
+	productCatalogSvcAddr string
+	productCatalogSvcConn *grpc.ClientConn
+
+	rates       *shippingRateEngine
+	fulfillment *fulfillmentPlanner
+	shipments   *shipmentStore
+
+	// now is the service's clock. Shipment status is derived from it, so
+	// replacing it moves shipments through their lifecycle.
+	now func() time.Time
 }
 
 // Run starts the server
 func (s *ShippingService) Run() error {
+	ctx := context.Background()
+
+	mustMapEnv(&s.productCatalogSvcAddr, "PRODUCT_CATALOG_SERVICE_ADDR")
+	mustConnGRPC(ctx, &s.productCatalogSvcConn, s.productCatalogSvcAddr)
+
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer())),
+		grpc.ChainUnaryInterceptor(
+			otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer()),
+			redact.UnaryServerInterceptor(),
+		),
 	}
 	srv := grpc.NewServer(opts...)
 	pb.RegisterShippingServiceServer(srv, s)
@@ -49,94 +102,173 @@ func (s *ShippingService) Run() error {
 // GetQuote calculates a shipping quote for a given address and items
 func (s *ShippingService) GetQuote(ctx context.Context, req *pb.GetQuoteRequest) (*pb.GetQuoteResponse, error) {
 	log.Printf("GetQuote request received for address: %v, %v, %v, %v, %v",
-		req.GetAddress().GetStreetAddress(),
+		redact.Street(req.GetAddress().GetStreetAddress()),
 		req.GetAddress().GetCity(),
 		req.GetAddress().GetState(),
 		req.GetAddress().GetCountry(),
-		req.GetAddress().GetZipCode())
+		postalCode(req.GetAddress()))
 
 	log.Printf("Calculating quote for %d items", len(req.GetItems()))
 
-	// Generate a quote based on item count
-	quote := createQuoteFromCount(len(req.GetItems()))
+	items, subtotal, err := s.shipmentItems(ctx, req.GetItems())
+	if err != nil {
+		return nil, err
+	}
 
-	response := &pb.GetQuoteResponse{
-		CostUsd: &pb.Money{
-			CurrencyCode: "USD",
-			Units:        int64(quote.Dollars),
-			Nanos:        int32(quote.Cents * 10000000),
-		},
+	// Quote whatever can be made of the address; ShipOrder and checkout
+	// are what refuse undeliverable ones.
+	addr := validateAddress(req.GetAddress()).GetNormalized()
+	options, err := s.rates.quote(addr, items, subtotal, s.now())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	return response, nil
+	level := req.GetServiceLevel()
+	if level == "" {
+		level = defaultServiceLevel
+	}
+	var chosen *pb.ShippingOption
+	for _, o := range options {
+		if o.GetServiceLevel() == level {
+			chosen = o
+			break
+		}
+	}
+	if chosen == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "service level %q is not available for this address", level)
+	}
+
+	return &pb.GetQuoteResponse{
+		CostUsd: chosen.GetCostUsd(),
+		Options: options,
+	}, nil
+}
+
+// shipmentItems looks up the product behind every cart item and returns them
+// with the order subtotal in USD.
+func (s *ShippingService) shipmentItems(ctx context.Context, cartItems []*pb.CartItem) ([]shipmentItem, *pb.Money, error) {
+	cl := pb.NewProductCatalogServiceClient(s.productCatalogSvcConn)
+
+	subtotal := &pb.Money{CurrencyCode: "USD"}
+	items := make([]shipmentItem, len(cartItems))
+	for i, item := range cartItems {
+		product, err := cl.GetProduct(ctx, &pb.GetProductRequest{Id: item.GetProductId()})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get product #%q: %w", item.GetProductId(), err)
+		}
+		items[i] = shipmentItem{product: product, quantity: item.GetQuantity()}
+
+		// Free-shipping thresholds are in USD, so a product priced in
+		// anything else would be left out of the subtotal.
+		if code := product.GetPriceUsd().GetCurrencyCode(); code != "USD" {
+			return nil, nil, fmt.Errorf("product #%q is priced in %q, not USD", item.GetProductId(), code)
+		}
+		subtotal = Must(Sum(subtotal, MultiplySlow(product.GetPriceUsd(), uint32(item.GetQuantity()))))
+	}
+	return items, subtotal, nil
 }
 
 // ShipOrder processes a shipping order and returns a tracking ID
 func (s *ShippingService) ShipOrder(ctx context.Context, req *pb.ShipOrderRequest) (*pb.ShipOrderResponse, error) {
 	log.Printf("ShipOrder request received for address: %v, %v, %v, %v, %v",
-		req.GetAddress().GetStreetAddress(),
+		redact.Street(req.GetAddress().GetStreetAddress()),
 		req.GetAddress().GetCity(),
 		req.GetAddress().GetState(),
 		req.GetAddress().GetCountry(),
-		req.GetAddress().GetZipCode())
+		postalCode(req.GetAddress()))
 
-	log.Printf("Shipping %d items", len(req.GetItems()))
+	level := req.GetServiceLevel()
+	if level == "" {
+		level = defaultServiceLevel
+	}
+	log.Printf("Shipping %d items with service level %q", len(req.GetItems()), level)
 
-	// Generate tracking ID
-	baseAddress := fmt.Sprintf("%s, %s, %s", req.GetAddress().GetStreetAddress(), req.GetAddress().GetCity(), req.GetAddress().GetState())
-	trackingID := createTrackingID(baseAddress)
+	validation := s.validate(req.GetAddress())
+	if !validation.GetDeliverable() {
+		return nil, status.Errorf(codes.InvalidArgument, "undeliverable address: %s", addressIssues(validation.GetIssues()))
+	}
 
-	response := &pb.ShipOrderResponse{
-		TrackingId: trackingID,
+	transitDays, err := s.rates.transitDays(validation.GetNormalized(), level)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	log.Printf("Order shipped with tracking ID: %v", trackingID)
+	parcels := s.fulfillment.plan(req.GetItems())
+	if len(parcels) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "order has no items to ship")
+	}
 
-	return response, nil
-}
+	resp := &pb.ShipOrderResponse{}
+	now := s.now()
+	for _, p := range parcels {
+		warehouse := s.fulfillment.warehouseName(p.warehouse)
+		sh, err := s.shipments.create(level, warehouse, p.items, now, int(transitDays))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create shipment: %v", err)
+		}
+		resp.Packages = append(resp.Packages, &pb.Package{
+			TrackingId: sh.trackingID,
+			Warehouse:  warehouse,
+			Items:      p.items,
+		})
+	}
+	resp.TrackingId = resp.Packages[0].GetTrackingId()
 
-// Quote represents a currency value.
-type quote struct {
-	Dollars uint32
-	Cents   uint32
-}
+	log.Printf("Order shipped in %d packages, first tracking ID: %v", len(resp.Packages), resp.TrackingId)
 
-// createQuoteFromCount generates a shipping quote based on item count.
-func createQuoteFromCount(_ int) quote {
-	return createQuoteFromFloat(8.99) // Example static rate
+	return resp, nil
 }
 
-// createQuoteFromFloat generates a quote from a float value.
-func createQuoteFromFloat(value float64) quote {
-	units, fraction := math.Modf(value)
-	return quote{
-		uint32(units),
-		uint32(math.Trunc(fraction * 100)),
+// TrackShipment returns the current status of a shipment
+func (s *ShippingService) TrackShipment(ctx context.Context, req *pb.TrackShipmentRequest) (*pb.Shipment, error) {
+	log.Printf("TrackShipment request received for tracking ID: %v", req.GetTrackingId())
+
+	sh, ok := s.shipments.get(req.GetTrackingId(), s.now())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no shipment with tracking ID %s", req.GetTrackingId())
 	}
+	return sh.toProto(s.now()), nil
 }
 
-// createTrackingID generates a tracking ID.
-func createTrackingID(salt string) string {
-	return fmt.Sprintf("%c%c-%d%s-%d%s",
-		getRandomLetterCode(),
-		getRandomLetterCode(),
-		len(salt),
-		getRandomNumber(3),
-		len(salt)/2,
-		getRandomNumber(7),
-	)
+// ValidateAddress normalizes an address and reports why it cannot be shipped
+// to, with suggested corrections where there are likely ones.
+func (s *ShippingService) ValidateAddress(ctx context.Context, req *pb.ValidateAddressRequest) (*pb.ValidateAddressResponse, error) {
+	log.Printf("ValidateAddress request received for address: %v, %v, %v, %v",
+		req.GetAddress().GetCity(),
+		req.GetAddress().GetState(),
+		req.GetAddress().GetCountry(),
+		postalCode(req.GetAddress()))
+
+	resp := s.validate(req.GetAddress())
+	if !resp.GetDeliverable() {
+		log.Printf("Address is undeliverable: %s", addressIssues(resp.GetIssues()))
+	}
+	return resp, nil
 }
 
-// getRandomLetterCode generates a code point value for a capital letter.
-func getRandomLetterCode() uint32 {
-	return 65 + uint32(rand.Intn(25))
+// validate checks an address's format and that some shipping zone covers it.
+func (s *ShippingService) validate(addr *pb.Address) *pb.ValidateAddressResponse {
+	resp := validateAddress(addr)
+	if resp.GetDeliverable() {
+		if _, err := s.rates.zoneFor(resp.GetNormalized()); err != nil {
+			resp.Deliverable = false
+			resp.Issues = append(resp.Issues, &pb.AddressIssue{
+				Field:   "country",
+				Message: fmt.Sprintf("we do not ship to %s", resp.GetNormalized().GetCountry()),
+			})
+		}
+	}
+	return resp
 }
 
-// getRandomNumber generates a string representation of a number with the requested number of digits.
-func getRandomNumber(digits int) string {
-	str := ""
-	for i := 0; i < digits; i++ {
-		str = fmt.Sprintf("%s%d", str, rand.Intn(10))
+// addressIssues renders issues as one line for errors and logs.
+func addressIssues(issues []*pb.AddressIssue) string {
+	msgs := make([]string, len(issues))
+	for i, is := range issues {
+		msgs[i] = is.GetMessage()
+		if is.GetSuggestion() != "" {
+			msgs[i] += fmt.Sprintf(" (did you mean %q?)", is.GetSuggestion())
+		}
 	}
-	return str
+	return strings.Join(msgs, "; ")
 }
@@ -0,0 +1,371 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// countryFormat describes how addresses are written in one country.
+type countryFormat struct {
+	code    string
+	name    string
+	aliases []string
+
+	// postal matches a canonical postal code. Countries without postal codes
+	// leave it nil.
+	postal *regexp.Regexp
+	// canonicalPostal rewrites an upper-cased, space-free postal code into
+	// its canonical form, e.g. "K1A0B1" into "K1A 0B1". It may return its
+	// input unchanged.
+	canonicalPostal func(string) string
+
+	// states maps subdivision codes to names. When set, the state field is
+	// required and must be one of them.
+	states map[string]string
+}
+
+var countryFormats = []*countryFormat{
+	{code: "US", name: "United States", aliases: []string{"USA", "United States of America", "America"},
+		postal: regexp.MustCompile(`^\d{5}(-\d{4})?$`), canonicalPostal: usZIP, states: usStates},
+	{code: "CA", name: "Canada", aliases: []string{"CAN"},
+		postal: regexp.MustCompile(`^[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] \d[ABCEGHJ-NPRSTV-Z]\d$`), canonicalPostal: splitLast(3), states: caProvinces},
+	{code: "MX", name: "Mexico", aliases: []string{"MEX", "México"},
+		postal: regexp.MustCompile(`^\d{5}$`)},
+	{code: "GB", name: "United Kingdom", aliases: []string{"GBR", "UK", "Great Britain", "England", "Scotland", "Wales", "Northern Ireland"},
+		postal: regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? \d[A-Z]{2}$`), canonicalPostal: splitLast(3)},
+	{code: "IE", name: "Ireland", aliases: []string{"IRL"},
+		postal: regexp.MustCompile(`^([AC-FHKNPRTV-Y]\d{2}|D6W) [\dAC-FHKNPRTV-Y]{4}$`), canonicalPostal: splitLast(4)},
+	{code: "FR", name: "France", aliases: []string{"FRA"},
+		postal: regexp.MustCompile(`^\d{5}$`)},
+	{code: "DE", name: "Germany", aliases: []string{"DEU", "Deutschland"},
+		postal: regexp.MustCompile(`^\d{5}$`)},
+	{code: "NL", name: "Netherlands", aliases: []string{"NLD", "Holland", "The Netherlands"},
+		postal: regexp.MustCompile(`^[1-9]\d{3} [A-Z]{2}$`), canonicalPostal: splitLast(2)},
+	{code: "BE", name: "Belgium", aliases: []string{"BEL"},
+		postal: regexp.MustCompile(`^\d{4}$`)},
+	{code: "ES", name: "Spain", aliases: []string{"ESP", "España"},
+		postal: regexp.MustCompile(`^\d{5}$`)},
+	{code: "IT", name: "Italy", aliases: []string{"ITA", "Italia"},
+		postal: regexp.MustCompile(`^\d{5}$`)},
+	{code: "PT", name: "Portugal", aliases: []string{"PRT"},
+		postal: regexp.MustCompile(`^\d{4}-\d{3}$`), canonicalPostal: insertAt(4, "-")},
+	{code: "CH", name: "Switzerland", aliases: []string{"CHE", "Schweiz", "Suisse"},
+		postal: regexp.MustCompile(`^\d{4}$`)},
+	{code: "AT", name: "Austria", aliases: []string{"AUT", "Österreich"},
+		postal: regexp.MustCompile(`^\d{4}$`)},
+	{code: "SE", name: "Sweden", aliases: []string{"SWE", "Sverige"},
+		postal: regexp.MustCompile(`^\d{3} \d{2}$`), canonicalPostal: splitLast(2)},
+	{code: "NO", name: "Norway", aliases: []string{"NOR", "Norge"},
+		postal: regexp.MustCompile(`^\d{4}$`)},
+	{code: "DK", name: "Denmark", aliases: []string{"DNK", "Danmark"},
+		postal: regexp.MustCompile(`^\d{4}$`)},
+	{code: "FI", name: "Finland", aliases: []string{"FIN", "Suomi"},
+		postal: regexp.MustCompile(`^\d{5}$`)},
+	{code: "PL", name: "Poland", aliases: []string{"POL", "Polska"},
+		postal: regexp.MustCompile(`^\d{2}-\d{3}$`), canonicalPostal: insertAt(2, "-")},
+	{code: "TR", name: "Turkey", aliases: []string{"TUR", "Türkiye", "Turkiye"},
+		postal: regexp.MustCompile(`^\d{5}$`)},
+	{code: "JP", name: "Japan", aliases: []string{"JPN"},
+		postal: regexp.MustCompile(`^\d{3}-\d{4}$`), canonicalPostal: insertAt(3, "-")},
+	{code: "CN", name: "China", aliases: []string{"CHN", "PRC"},
+		postal: regexp.MustCompile(`^\d{6}$`)},
+	{code: "KR", name: "South Korea", aliases: []string{"KOR", "Korea", "Republic of Korea"},
+		postal: regexp.MustCompile(`^\d{5}$`)},
+	{code: "IN", name: "India", aliases: []string{"IND"},
+		postal: regexp.MustCompile(`^[1-9]\d{5}$`)},
+	{code: "SG", name: "Singapore", aliases: []string{"SGP"},
+		postal: regexp.MustCompile(`^\d{6}$`)},
+	{code: "HK", name: "Hong Kong", aliases: []string{"HKG"}},
+	{code: "AE", name: "United Arab Emirates", aliases: []string{"ARE", "UAE"}},
+	{code: "AU", name: "Australia", aliases: []string{"AUS"},
+		postal: regexp.MustCompile(`^\d{4}$`), states: auStates},
+	{code: "NZ", name: "New Zealand", aliases: []string{"NZL"},
+		postal: regexp.MustCompile(`^\d{4}$`)},
+	{code: "BR", name: "Brazil", aliases: []string{"BRA", "Brasil"},
+		postal: regexp.MustCompile(`^\d{5}-\d{3}$`), canonicalPostal: insertAt(5, "-")},
+	{code: "AR", name: "Argentina", aliases: []string{"ARG"},
+		postal: regexp.MustCompile(`^([A-Z]\d{4}[A-Z]{3}|\d{4})$`)},
+	{code: "ZA", name: "South Africa", aliases: []string{"ZAF"},
+		postal: regexp.MustCompile(`^\d{4}$`)},
+}
+
+var usStates = map[string]string{
+	"AL": "Alabama", "AK": "Alaska", "AZ": "Arizona", "AR": "Arkansas",
+	"CA": "California", "CO": "Colorado", "CT": "Connecticut", "DE": "Delaware",
+	"DC": "District of Columbia", "FL": "Florida", "GA": "Georgia", "HI": "Hawaii",
+	"ID": "Idaho", "IL": "Illinois", "IN": "Indiana", "IA": "Iowa",
+	"KS": "Kansas", "KY": "Kentucky", "LA": "Louisiana", "ME": "Maine",
+	"MD": "Maryland", "MA": "Massachusetts", "MI": "Michigan", "MN": "Minnesota",
+	"MS": "Mississippi", "MO": "Missouri", "MT": "Montana", "NE": "Nebraska",
+	"NV": "Nevada", "NH": "New Hampshire", "NJ": "New Jersey", "NM": "New Mexico",
+	"NY": "New York", "NC": "North Carolina", "ND": "North Dakota", "OH": "Ohio",
+	"OK": "Oklahoma", "OR": "Oregon", "PA": "Pennsylvania", "RI": "Rhode Island",
+	"SC": "South Carolina", "SD": "South Dakota", "TN": "Tennessee", "TX": "Texas",
+	"UT": "Utah", "VT": "Vermont", "VA": "Virginia", "WA": "Washington",
+	"WV": "West Virginia", "WI": "Wisconsin", "WY": "Wyoming",
+	"AS": "American Samoa", "GU": "Guam", "MP": "Northern Mariana Islands",
+	"PR": "Puerto Rico", "VI": "U.S. Virgin Islands",
+	"AA": "Armed Forces Americas", "AE": "Armed Forces Europe", "AP": "Armed Forces Pacific",
+}
+
+var caProvinces = map[string]string{
+	"AB": "Alberta", "BC": "British Columbia", "MB": "Manitoba",
+	"NB": "New Brunswick", "NL": "Newfoundland and Labrador", "NS": "Nova Scotia",
+	"NT": "Northwest Territories", "NU": "Nunavut", "ON": "Ontario",
+	"PE": "Prince Edward Island", "QC": "Quebec", "SK": "Saskatchewan",
+	"YT": "Yukon",
+}
+
+var auStates = map[string]string{
+	"ACT": "Australian Capital Territory", "NSW": "New South Wales",
+	"NT": "Northern Territory", "QLD": "Queensland", "SA": "South Australia",
+	"TAS": "Tasmania", "VIC": "Victoria", "WA": "Western Australia",
+}
+
+// countryIndex maps every upper-cased code, name and alias to its country.
+var countryIndex = func() map[string]*countryFormat {
+	idx := map[string]*countryFormat{}
+	for _, c := range countryFormats {
+		idx[c.code] = c
+		idx[strings.ToUpper(c.name)] = c
+		for _, a := range c.aliases {
+			idx[strings.ToUpper(a)] = c
+		}
+	}
+	return idx
+}()
+
+// usZIP accepts a nine-digit ZIP written without its hyphen.
+func usZIP(s string) string {
+	if len(s) == 9 && isDigits(s) {
+		return s[:5] + "-" + s[5:]
+	}
+	return s
+}
+
+// splitLast returns a canonicalPostal that puts a space before the last n
+// characters, as UK and Canadian postcodes are written.
+func splitLast(n int) func(string) string {
+	return func(s string) string {
+		if len(s) <= n {
+			return s
+		}
+		return s[:len(s)-n] + " " + s[len(s)-n:]
+	}
+}
+
+// insertAt returns a canonicalPostal that inserts sep after the first i
+// characters of an all-digit code that lacks it.
+func insertAt(i int, sep string) func(string) string {
+	return func(s string) string {
+		if len(s) <= i || !isDigits(s) {
+			return s
+		}
+		return s[:i] + sep + s[i:]
+	}
+}
+
+// lookupCountry returns the country for an ISO code, English name or common
+// alias, ignoring case and surrounding space.
+func lookupCountry(country string) (*countryFormat, bool) {
+	c, ok := countryIndex[strings.ToUpper(strings.Join(strings.Fields(country), " "))]
+	return c, ok
+}
+
+// normalizeCountry returns the ISO 3166-1 alpha-2 code for a country name or
+// code, or the upper-cased input if it is not recognised.
+func normalizeCountry(country string) string {
+	if c, ok := lookupCountry(country); ok {
+		return c.code
+	}
+	return strings.ToUpper(strings.TrimSpace(country))
+}
+
+// postalCode returns the address's postal code as written, falling back to
+// the legacy numeric field. US ZIP codes from the legacy field are
+// zero-padded to five digits.
+func postalCode(addr *pb.Address) string {
+	if pc := strings.TrimSpace(addr.GetPostalCode()); pc != "" {
+		return strings.ToUpper(pc)
+	}
+	if addr.GetZipCode() == 0 {
+		return ""
+	}
+	if normalizeCountry(addr.GetCountry()) == "US" {
+		return fmt.Sprintf("%05d", addr.GetZipCode())
+	}
+	return strconv.Itoa(int(addr.GetZipCode()))
+}
+
+// validateAddress normalizes addr and reports anything that would stop a
+// parcel reaching it. Unambiguous fixes, such as "california" for "CA" or
+// "k1a0b1" for "K1A 0B1", are applied silently; likely typos are reported
+// with a suggestion instead of being guessed at.
+func validateAddress(addr *pb.Address) *pb.ValidateAddressResponse {
+	norm := &pb.Address{
+		StreetAddress: strings.TrimSpace(addr.GetStreetAddress()),
+		City:          strings.TrimSpace(addr.GetCity()),
+		State:         strings.TrimSpace(addr.GetState()),
+		Country:       strings.TrimSpace(addr.GetCountry()),
+		ZipCode:       addr.GetZipCode(),
+		PostalCode:    strings.TrimSpace(addr.GetPostalCode()),
+	}
+	var issues []*pb.AddressIssue
+	issue := func(field, msg, suggestion string) {
+		issues = append(issues, &pb.AddressIssue{Field: field, Message: msg, Suggestion: suggestion})
+	}
+
+	if norm.StreetAddress == "" {
+		issue("street_address", "street address is required", "")
+	}
+	if norm.City == "" {
+		issue("city", "city is required", "")
+	}
+
+	country, ok := lookupCountry(norm.Country)
+	if !ok {
+		if norm.Country == "" {
+			issue("country", "country is required", "")
+		} else {
+			issue("country", fmt.Sprintf("unknown country %q", norm.Country), suggestCountry(norm.Country))
+		}
+		norm.PostalCode = postalCode(addr)
+		return &pb.ValidateAddressResponse{Normalized: norm, Issues: issues}
+	}
+	norm.Country = country.code
+
+	if country.states != nil {
+		state, suggestion, ok := matchState(country.states, norm.State)
+		switch {
+		case ok:
+			norm.State = state
+		case norm.State == "":
+			issue("state", fmt.Sprintf("a state or province is required for %s", country.name), "")
+		default:
+			issue("state", fmt.Sprintf("%q is not a state or province of %s", norm.State, country.name), suggestion)
+		}
+	}
+
+	pc := postalCode(norm)
+	if country.postal != nil {
+		compact := strings.Join(strings.Fields(pc), "")
+		if country.canonicalPostal != nil {
+			compact = country.canonicalPostal(compact)
+		}
+		switch {
+		case pc == "":
+			issue("postal_code", fmt.Sprintf("a postal code is required for %s", country.name), "")
+		case country.postal.MatchString(compact):
+			pc = compact
+		default:
+			issue("postal_code", fmt.Sprintf("%q is not a valid %s postal code", pc, country.name), "")
+		}
+	}
+	norm.PostalCode = pc
+	norm.ZipCode = legacyZIPFor(country.code, pc)
+
+	return &pb.ValidateAddressResponse{
+		Deliverable: len(issues) == 0,
+		Normalized:  norm,
+		Issues:      issues,
+	}
+}
+
+// legacyZIPFor fills the legacy numeric field from a normalized postal code
+// so that older readers keep working. Postcodes that are not numbers leave
+// it zero.
+func legacyZIPFor(country, pc string) int32 {
+	if country == "US" && len(pc) > 5 {
+		pc = pc[:5]
+	}
+	n, err := strconv.ParseInt(pc, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(n)
+}
+
+// matchState returns the subdivision code for a code or full name. When
+// there is no exact match it returns the closest code as a suggestion.
+func matchState(states map[string]string, state string) (code, suggestion string, ok bool) {
+	s := strings.ToUpper(strings.Join(strings.Fields(state), " "))
+	if s == "" {
+		return "", "", false
+	}
+	if _, ok := states[s]; ok {
+		return s, "", true
+	}
+	for code, name := range states {
+		if strings.ToUpper(name) == s {
+			return code, "", true
+		}
+	}
+	names := make(map[string]string, len(states))
+	for code, name := range states {
+		names[strings.ToUpper(name)] = code
+	}
+	if best := closest(s, names); best != "" {
+		return "", best, false
+	}
+	return "", "", false
+}
+
+// suggestCountry returns the ISO code of the country whose name is closest
+// to country, if any is close enough to be a likely typo.
+func suggestCountry(country string) string {
+	names := map[string]string{}
+	for _, c := range countryFormats {
+		names[strings.ToUpper(c.name)] = c.code
+		for _, a := range c.aliases {
+			names[strings.ToUpper(a)] = c.code
+		}
+	}
+	return closest(strings.ToUpper(strings.TrimSpace(country)), names)
+}
+
+// closest returns the value for the key nearest to s by edit distance,
+// allowing roughly one typo per four characters. Ties go to the smallest
+// key so that results are stable.
+func closest(s string, candidates map[string]string) string {
+	keys := make([]string, 0, len(candidates))
+	for k := range candidates {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	maxDist := len(s)/4 + 1
+	best, bestDist := "", maxDist+1
+	for _, k := range keys {
+		if d := editDistance(s, k); d < bestDist {
+			best, bestDist = candidates[k], d
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
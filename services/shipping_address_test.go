@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+func TestValidateAddress(t *testing.T) {
+	addr := func(country, state, postal string, zip int32) *pb.Address {
+		return &pb.Address{
+			StreetAddress: " 1 Main Street ",
+			City:          "Springfield",
+			State:         state,
+			Country:       country,
+			PostalCode:    postal,
+			ZipCode:       zip,
+		}
+	}
+	for _, tc := range []struct {
+		name string
+		addr *pb.Address
+		// want is the normalized country, state, postal code and legacy
+		// ZIP when the address is deliverable.
+		want *pb.Address
+		// wantIssues lists field=suggestion for each issue otherwise.
+		wantIssues []string
+	}{
+		{"US ZIP+4 without hyphen", addr("usa", "california", "940431351", 0),
+			&pb.Address{Country: "US", State: "CA", PostalCode: "94043-1351", ZipCode: 94043}, nil},
+		{"US ZIP+4", addr("United States of America", "ca", " 94043-1351 ", 0),
+			&pb.Address{Country: "US", State: "CA", PostalCode: "94043-1351", ZipCode: 94043}, nil},
+		{"US leading-zero legacy ZIP", addr("US", "Massachusetts", "", 2139),
+			&pb.Address{Country: "US", State: "MA", PostalCode: "02139", ZipCode: 2139}, nil},
+		{"US leading-zero ZIP", addr("us", "MA", "02139", 0),
+			&pb.Address{Country: "US", State: "MA", PostalCode: "02139", ZipCode: 2139}, nil},
+		{"UK", addr("uk", "", "sw1a1aa", 0),
+			&pb.Address{Country: "GB", PostalCode: "SW1A 1AA"}, nil},
+		{"UK with space", addr("England", "", "EC1A 1BB", 0),
+			&pb.Address{Country: "GB", PostalCode: "EC1A 1BB"}, nil},
+		{"Canada", addr("Canada", "ontario", "k1a0b1", 0),
+			&pb.Address{Country: "CA", State: "ON", PostalCode: "K1A 0B1"}, nil},
+		{"Portugal", addr("PRT", "", "1000001", 0),
+			&pb.Address{Country: "PT", PostalCode: "1000-001"}, nil},
+		{"unknown country", addr("Germnay", "", "10115", 0), nil, []string{"country=DE"}},
+		{"missing country", addr("", "", "10115", 0), nil, []string{"country="}},
+		{"misspelt state", addr("US", "Califrnia", "94043", 0), nil, []string{"state=CA"}},
+		{"missing state", addr("Canada", "", "K1A 0B1", 0), nil, []string{"state="}},
+		{"bad US ZIP", addr("US", "CA", "9404", 0), nil, []string{"postal_code="}},
+		{"bad Canadian postal code", addr("CA", "ON", "D1A 0B1", 0), nil, []string{"postal_code="}},
+		{"missing postal code", addr("DE", "", "", 0), nil, []string{"postal_code="}},
+		{"missing street and city", &pb.Address{Country: "FR", PostalCode: "75001"}, nil, []string{"street_address=", "city="}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := validateAddress(tc.addr)
+			var issues []string
+			for _, is := range resp.GetIssues() {
+				issues = append(issues, is.GetField()+"="+is.GetSuggestion())
+			}
+			if strings.Join(issues, ",") != strings.Join(tc.wantIssues, ",") {
+				t.Errorf("issues = %v, want %v", issues, tc.wantIssues)
+			}
+			if resp.GetDeliverable() != (tc.want != nil) {
+				t.Errorf("Deliverable = %v", resp.GetDeliverable())
+			}
+			if tc.want == nil {
+				return
+			}
+			got := resp.GetNormalized()
+			if got.GetCountry() != tc.want.GetCountry() || got.GetState() != tc.want.GetState() ||
+				got.GetPostalCode() != tc.want.GetPostalCode() || got.GetZipCode() != tc.want.GetZipCode() {
+				t.Errorf("normalized to %s %s %q (%d), want %s %s %q (%d)",
+					got.GetCountry(), got.GetState(), got.GetPostalCode(), got.GetZipCode(),
+					tc.want.GetCountry(), tc.want.GetState(), tc.want.GetPostalCode(), tc.want.GetZipCode())
+			}
+			if got.GetStreetAddress() != strings.TrimSpace(tc.addr.GetStreetAddress()) {
+				t.Errorf("StreetAddress = %q", got.GetStreetAddress())
+			}
+		})
+	}
+}
+
+// TestCheckoutRejectsUndeliverableAddress places an order through the real
+// address validation and checks that it fails before any payment.
+func TestCheckoutRejectsUndeliverableAddress(t *testing.T) {
+	ratesData, err := os.ReadFile(shippingRatesFilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rates, err := newShippingRateEngine(ratesData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shipping := &ShippingService{rates: rates}
+	payment := &fakePayment{}
+	none := dialServer(t, func(*grpc.Server) {})
+	cs := &CheckoutService{
+		shippingSvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterShippingServiceServer(s, shipping) }),
+		paymentSvcConn:        dialServer(t, func(s *grpc.Server) { pb.RegisterPaymentServiceServer(s, payment) }),
+		productCatalogSvcConn: none,
+		cartSvcConn:           none,
+		currencySvcConn:       none,
+		emailSvcConn:          none,
+		recommendationSvcConn: none,
+	}
+
+	_, err = cs.PlaceOrder(context.Background(), &pb.PlaceOrderRequest{
+		UserId:       "user",
+		UserCurrency: "USD",
+		Email:        "someone@example.com",
+		Address: &pb.Address{
+			StreetAddress: "1600 Amphitheatre Parkway",
+			City:          "Mountain View",
+			State:         "Califrnia",
+			Country:       "United States",
+			PostalCode:    "94043",
+		},
+		CreditCard: &pb.CreditCardInfo{CreditCardNumber: "4432801561520454"},
+	})
+	if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), "undeliverable address") {
+		t.Errorf("PlaceOrder to a misspelt state: err = %v, want undeliverable address", err)
+	}
+	if len(payment.ops) != 0 {
+		t.Errorf("payment operations %v for an undeliverable address", payment.ops)
+	}
+}
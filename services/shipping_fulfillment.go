@@ -0,0 +1,163 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+const fulfillmentFilePath = "data/fulfillment.json"
+
+// fulfillmentConfig is the on-disk format of fulfillmentFilePath.
+type fulfillmentConfig struct {
+	// DefaultWarehouse ships products without a warehouse of their own.
+	DefaultWarehouse string            `json:"defaultWarehouse"`
+	MaxItemsPerBox   int32             `json:"maxItemsPerBox"`
+	Warehouses       []warehouseConfig `json:"warehouses"`
+	// Products holds per-product constraints, keyed by product ID. Products
+	// not listed ship from the default warehouse with no limits of their own.
+	Products map[string]productFulfillment `json:"products"`
+}
+
+type warehouseConfig struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type productFulfillment struct {
+	Warehouse string `json:"warehouse"`
+	// MaxPerBox caps how many units of the product go in one box.
+	MaxPerBox int32 `json:"maxPerBox"`
+	// ShipsAlone keeps the product out of boxes holding anything else.
+	ShipsAlone bool `json:"shipsAlone"`
+}
+
+// fulfillmentPlanner splits an order into the boxes it ships in.
+type fulfillmentPlanner struct {
+	cfg fulfillmentConfig
+}
+
+// parcel is one planned box.
+type parcel struct {
+	warehouse string
+	items     []*pb.CartItem
+	count     int32
+	alone     bool
+}
+
+// newFulfillmentPlanner parses and validates fulfilment data.
+func newFulfillmentPlanner(data []byte) (*fulfillmentPlanner, error) {
+	var cfg fulfillmentConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.MaxItemsPerBox <= 0 {
+		return nil, fmt.Errorf("maxItemsPerBox must be positive")
+	}
+	warehouses := map[string]bool{}
+	for _, w := range cfg.Warehouses {
+		warehouses[w.ID] = true
+	}
+	if !warehouses[cfg.DefaultWarehouse] {
+		return nil, fmt.Errorf("unknown default warehouse %q", cfg.DefaultWarehouse)
+	}
+	for id, p := range cfg.Products {
+		if p.Warehouse != "" && !warehouses[p.Warehouse] {
+			return nil, fmt.Errorf("product %s: unknown warehouse %q", id, p.Warehouse)
+		}
+		if p.MaxPerBox < 0 {
+			return nil, fmt.Errorf("product %s: maxPerBox must not be negative", id)
+		}
+	}
+	return &fulfillmentPlanner{cfg: cfg}, nil
+}
+
+// constraints returns the warehouse and per-box limit for a product.
+func (f *fulfillmentPlanner) constraints(productID string) (warehouse string, maxPerBox int32, alone bool) {
+	p := f.cfg.Products[productID]
+	warehouse = p.Warehouse
+	if warehouse == "" {
+		warehouse = f.cfg.DefaultWarehouse
+	}
+	maxPerBox = p.MaxPerBox
+	if maxPerBox == 0 || maxPerBox > f.cfg.MaxItemsPerBox {
+		maxPerBox = f.cfg.MaxItemsPerBox
+	}
+	return warehouse, maxPerBox, p.ShipsAlone
+}
+
+// warehouseName returns the display name of a warehouse.
+func (f *fulfillmentPlanner) warehouseName(id string) string {
+	for _, w := range f.cfg.Warehouses {
+		if w.ID == id {
+			return w.Name
+		}
+	}
+	return id
+}
+
+// plan packs items into boxes, first fit in cart order. Boxes are grouped by
+// warehouse in the order the warehouses are configured, so the same cart
+// always yields the same plan.
+func (f *fulfillmentPlanner) plan(items []*pb.CartItem) []*parcel {
+	byWarehouse := map[string][]*parcel{}
+	for _, item := range items {
+		warehouse, maxPerBox, alone := f.constraints(item.GetProductId())
+		remaining := item.GetQuantity()
+		for remaining > 0 {
+			box := f.boxFor(byWarehouse[warehouse], item.GetProductId(), maxPerBox, alone)
+			if box == nil {
+				box = &parcel{warehouse: warehouse, alone: alone}
+				byWarehouse[warehouse] = append(byWarehouse[warehouse], box)
+			}
+			n := min(remaining, f.cfg.MaxItemsPerBox-box.count, maxPerBox-unitsOf(box, item.GetProductId()))
+			box.add(item, n)
+			remaining -= n
+		}
+	}
+
+	var parcels []*parcel
+	for _, w := range f.cfg.Warehouses {
+		parcels = append(parcels, byWarehouse[w.ID]...)
+	}
+	return parcels
+}
+
+// boxFor returns the first box with room for another unit of productID.
+func (f *fulfillmentPlanner) boxFor(boxes []*parcel, productID string, maxPerBox int32, alone bool) *parcel {
+	for _, box := range boxes {
+		if box.count >= f.cfg.MaxItemsPerBox || unitsOf(box, productID) >= maxPerBox {
+			continue
+		}
+		// A product that ships alone may only share with more of itself.
+		if (alone || box.alone) && (len(box.items) == 0 || unitsOf(box, productID) != box.count) {
+			continue
+		}
+		return box
+	}
+	return nil
+}
+
+// unitsOf counts the units of productID in box, across all its SKUs.
+func unitsOf(box *parcel, productID string) int32 {
+	var n int32
+	for _, it := range box.items {
+		if it.GetProductId() == productID {
+			n += it.GetQuantity()
+		}
+	}
+	return n
+}
+
+// add puts n units of item's product and SKU in p.
+func (p *parcel) add(item *pb.CartItem, n int32) {
+	p.count += n
+	for _, it := range p.items {
+		if it.GetProductId() == item.GetProductId() && it.GetSku() == item.GetSku() {
+			it.Quantity += n
+			return
+		}
+	}
+	p.items = append(p.items, &pb.CartItem{ProductId: item.GetProductId(), Sku: item.GetSku(), Quantity: n})
+}
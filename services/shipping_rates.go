@@ -0,0 +1,277 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+const (
+	shippingRatesFilePath = "data/shipping_rates.json"
+
+	defaultServiceLevel = "standard"
+)
+
+// rateTableConfig is the on-disk format of shippingRatesFilePath.
+type rateTableConfig struct {
+	// DefaultWeightGrams is used for products without a weight.
+	DefaultWeightGrams int32 `json:"defaultWeightGrams"`
+	// DimensionalDivisor converts cubic centimetres into billable
+	// kilograms, as carriers do for light but bulky parcels.
+	DimensionalDivisor int32                `json:"dimensionalDivisor"`
+	ServiceLevels      []serviceLevelConfig `json:"serviceLevels"`
+	Zones              []zoneConfig         `json:"zones"`
+}
+
+type serviceLevelConfig struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// zoneConfig prices shipments to any address matching one of its rules.
+type zoneConfig struct {
+	Name  string                     `json:"name"`
+	Match []zoneRule                 `json:"match"`
+	Rates map[string]levelRateConfig `json:"rates"`
+}
+
+// zoneRule matches an address; empty fields match anything.
+type zoneRule struct {
+	Country   string `json:"country"`
+	State     string `json:"state"`
+	ZipPrefix string `json:"zipPrefix"`
+}
+
+// levelRateConfig prices one service level within a zone. A service level
+// missing from a zone's rates is not offered there.
+type levelRateConfig struct {
+	TransitDays int32        `json:"transitDays"`
+	Tiers       []weightTier `json:"tiers"`
+	// PerExtraKg is charged for every started kilogram above the last tier.
+	PerExtraKg string `json:"perExtraKg"`
+	// FreeOverUsd makes this level free when the order subtotal reaches it.
+	FreeOverUsd string `json:"freeOverUsd"`
+}
+
+type weightTier struct {
+	MaxGrams int32  `json:"maxGrams"`
+	Cost     string `json:"cost"`
+}
+
+// shippingRateEngine turns an address, the products in a shipment and their
+// subtotal into priced shipping options.
+type shippingRateEngine struct {
+	cfg rateTableConfig
+}
+
+// shipmentItem is a product and how many of it are shipped.
+type shipmentItem struct {
+	product  *pb.Product
+	quantity int32
+}
+
+// newShippingRateEngine parses and validates rate table data.
+func newShippingRateEngine(data []byte) (*shippingRateEngine, error) {
+	var cfg rateTableConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.DimensionalDivisor <= 0 {
+		return nil, fmt.Errorf("dimensionalDivisor must be positive")
+	}
+	levels := map[string]bool{}
+	for _, l := range cfg.ServiceLevels {
+		levels[l.ID] = true
+	}
+	for _, z := range cfg.Zones {
+		for id, r := range z.Rates {
+			if !levels[id] {
+				return nil, fmt.Errorf("zone %s: unknown service level %q", z.Name, id)
+			}
+			// A shipment is picked up before it is delivered, so nothing
+			// arrives the day it is ordered.
+			if r.TransitDays < 1 {
+				r.TransitDays = 1
+				z.Rates[id] = r
+			}
+			if len(r.Tiers) == 0 {
+				return nil, fmt.Errorf("zone %s: service level %s has no weight tiers", z.Name, id)
+			}
+			// levelCost picks the first tier a shipment fits, so tiers
+			// must go from lightest to heaviest.
+			sort.Slice(r.Tiers, func(i, j int) bool { return r.Tiers[i].MaxGrams < r.Tiers[j].MaxGrams })
+			for i, t := range r.Tiers {
+				if t.MaxGrams <= 0 || i > 0 && t.MaxGrams == r.Tiers[i-1].MaxGrams {
+					return nil, fmt.Errorf("zone %s: service level %s has an invalid or duplicate tier of %d grams", z.Name, id, t.MaxGrams)
+				}
+				if _, err := parseDecimal(t.Cost); err != nil {
+					return nil, fmt.Errorf("zone %s: %v", z.Name, err)
+				}
+			}
+			for _, d := range []string{r.PerExtraKg, r.FreeOverUsd} {
+				if d == "" {
+					continue
+				}
+				if _, err := parseDecimal(d); err != nil {
+					return nil, fmt.Errorf("zone %s: %v", z.Name, err)
+				}
+			}
+		}
+	}
+	return &shippingRateEngine{cfg: cfg}, nil
+}
+
+func parseDecimal(s string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || r.Sign() < 0 {
+		return nil, fmt.Errorf("invalid amount %q", s)
+	}
+	return r, nil
+}
+
+// zoneFor returns the zone whose most specific matching rule is the most
+// specific overall. A ZIP prefix beats a state, which beats a country.
+func (e *shippingRateEngine) zoneFor(addr *pb.Address) (*zoneConfig, error) {
+	country := normalizeCountry(addr.GetCountry())
+	state := strings.ToUpper(strings.TrimSpace(addr.GetState()))
+	zip := postalCode(addr)
+
+	var best *zoneConfig
+	bestScore := -1
+	for i := range e.cfg.Zones {
+		z := &e.cfg.Zones[i]
+		for _, r := range z.Match {
+			if r.Country != "" && r.Country != country {
+				continue
+			}
+			if r.State != "" && !strings.EqualFold(r.State, state) {
+				continue
+			}
+			if r.ZipPrefix != "" && !strings.HasPrefix(zip, r.ZipPrefix) {
+				continue
+			}
+			score := 0
+			if r.Country != "" {
+				score++
+			}
+			if r.State != "" {
+				score += 10
+			}
+			if r.ZipPrefix != "" {
+				score += 100 + len(r.ZipPrefix)
+			}
+			if score > bestScore {
+				best, bestScore = z, score
+			}
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("no shipping zone covers %s", addr.GetCountry())
+	}
+	return best, nil
+}
+
+// billableGrams sums, over all items, the larger of actual and dimensional
+// weight.
+func (e *shippingRateEngine) billableGrams(items []shipmentItem) int64 {
+	var total int64
+	for _, it := range items {
+		actual := int64(it.product.GetWeightGrams())
+		if actual <= 0 {
+			actual = int64(e.cfg.DefaultWeightGrams)
+		}
+		d := it.product.GetDimensions()
+		volume := int64(d.GetLengthCm()) * int64(d.GetWidthCm()) * int64(d.GetHeightCm())
+		dimensional := volume * 1000 / int64(e.cfg.DimensionalDivisor)
+		if dimensional > actual {
+			actual = dimensional
+		}
+		total += actual * int64(it.quantity)
+	}
+	return total
+}
+
+// levelCost prices a shipment of grams under r, whose tiers are sorted.
+func levelCost(r levelRateConfig, grams int64, subtotalUSD *big.Rat) *big.Rat {
+	if r.FreeOverUsd != "" {
+		threshold, _ := parseDecimal(r.FreeOverUsd)
+		if subtotalUSD.Cmp(threshold) >= 0 {
+			return new(big.Rat)
+		}
+	}
+	for _, t := range r.Tiers {
+		if grams <= int64(t.MaxGrams) {
+			cost, _ := parseDecimal(t.Cost)
+			return cost
+		}
+	}
+	last := r.Tiers[len(r.Tiers)-1]
+	cost, _ := parseDecimal(last.Cost)
+	if r.PerExtraKg != "" {
+		perKg, _ := parseDecimal(r.PerExtraKg)
+		extraKg := (grams - int64(last.MaxGrams) + 999) / 1000
+		cost.Add(cost, new(big.Rat).Mul(perKg, big.NewRat(extraKg, 1)))
+	}
+	return cost
+}
+
+// quote returns every service level available for addr, cheapest first.
+func (e *shippingRateEngine) quote(addr *pb.Address, items []shipmentItem, subtotalUSD *pb.Money, now time.Time) ([]*pb.ShippingOption, error) {
+	zone, err := e.zoneFor(addr)
+	if err != nil {
+		return nil, err
+	}
+	grams := e.billableGrams(items)
+	subtotal := moneyToRat(subtotalUSD)
+
+	var options []*pb.ShippingOption
+	for _, level := range e.cfg.ServiceLevels {
+		r, ok := zone.Rates[level.ID]
+		if !ok {
+			continue
+		}
+		cost := ratToMoney(levelCost(r, grams, subtotal))
+		cost.CurrencyCode = "USD"
+		options = append(options, &pb.ShippingOption{
+			ServiceLevel:          level.ID,
+			Name:                  level.Name,
+			CostUsd:               cost,
+			TransitDays:           r.TransitDays,
+			EstimatedDeliveryDate: addBusinessDays(now, int(r.TransitDays)).Format("2006-01-02"),
+		})
+	}
+	sort.SliceStable(options, func(i, j int) bool {
+		return moneyToRat(options[i].GetCostUsd()).Cmp(moneyToRat(options[j].GetCostUsd())) < 0
+	})
+	return options, nil
+}
+
+// transitDays returns how many business days level takes to reach addr.
+func (e *shippingRateEngine) transitDays(addr *pb.Address, level string) (int32, error) {
+	zone, err := e.zoneFor(addr)
+	if err != nil {
+		return 0, err
+	}
+	r, ok := zone.Rates[level]
+	if !ok {
+		return 0, fmt.Errorf("service level %q is not available for this address", level)
+	}
+	return r.TransitDays, nil
+}
+
+// addBusinessDays returns the date days working days after t, skipping
+// weekends.
+func addBusinessDays(t time.Time, days int) time.Time {
+	for days > 0 {
+		t = t.AddDate(0, 0, 1)
+		if wd := t.Weekday(); wd != time.Saturday && wd != time.Sunday {
+			days--
+		}
+	}
+	return t
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+func TestShippingRateTiersSortedAtLoad(t *testing.T) {
+	e, err := newShippingRateEngine([]byte(`{
+		"dimensionalDivisor": 5000,
+		"serviceLevels": [{"id": "standard", "name": "Standard"}],
+		"zones": [{"name": "us", "match": [{"country": "US"}], "rates": {"standard": {
+			"tiers": [
+				{"maxGrams": 5000, "cost": "12.99"},
+				{"maxGrams": 500, "cost": "5.99"},
+				{"maxGrams": 2000, "cost": "8.99"}
+			],
+			"perExtraKg": "1.50"
+		}}}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := e.cfg.Zones[0].Rates["standard"]
+	for _, tc := range []struct {
+		grams int64
+		want  string
+	}{
+		{400, "5.99"},
+		{500, "5.99"},
+		{1500, "8.99"},
+		{4000, "12.99"},
+		{6500, "15.99"},
+	} {
+		want, _ := new(big.Rat).SetString(tc.want)
+		if got := levelCost(r, tc.grams, new(big.Rat)); got.Cmp(want) != 0 {
+			t.Errorf("levelCost(%d g) = %s, want %s", tc.grams, got.FloatString(2), tc.want)
+		}
+	}
+}
+
+func TestShippingRateTiersRejectDuplicates(t *testing.T) {
+	_, err := newShippingRateEngine([]byte(`{
+		"dimensionalDivisor": 5000,
+		"serviceLevels": [{"id": "standard", "name": "Standard"}],
+		"zones": [{"name": "us", "match": [{"country": "US"}], "rates": {"standard": {
+			"tiers": [{"maxGrams": 500, "cost": "5.99"}, {"maxGrams": 500, "cost": "6.99"}]
+		}}}]
+	}`))
+	if err == nil || !strings.Contains(err.Error(), "duplicate tier") {
+		t.Errorf("newShippingRateEngine with duplicate tiers: err = %v", err)
+	}
+}
+
+func TestShippingRateTransitDaysAtLeastOne(t *testing.T) {
+	e, err := newShippingRateEngine([]byte(`{
+		"dimensionalDivisor": 5000,
+		"serviceLevels": [{"id": "same-day", "name": "Same day"}],
+		"zones": [{"name": "us", "match": [{"country": "US"}], "rates": {"same-day": {
+			"transitDays": 0,
+			"tiers": [{"maxGrams": 500, "cost": "25.00"}]
+		}}}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if days, err := e.transitDays(&pb.Address{Country: "US"}, "same-day"); err != nil || days != 1 {
+		t.Errorf("transitDays = %d, %v; want 1", days, err)
+	}
+}
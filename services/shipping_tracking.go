@@ -0,0 +1,179 @@
+package services
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// inTransitAfter is how long a shipment sits at "label created" before the
+// carrier picks it up.
+const inTransitAfter = 2 * time.Hour
+
+// shipment is a stored shipment. Its status is not stored: it is derived
+// from the creation time and the clock whenever the shipment is read, so
+// moving the clock moves the shipment along.
+type shipment struct {
+	trackingID   string
+	serviceLevel string
+	warehouse    string
+	items        []*pb.CartItem
+	createdAt    time.Time
+	deliverAt    time.Time
+}
+
+// Defaults for how long and how many shipments the store keeps; see
+// shipmentStore.
+const (
+	defaultShipmentRetention = 30 * 24 * time.Hour
+	defaultMaxShipments      = 100000
+)
+
+// shipmentStore keeps the shipments the service has created, keyed by
+// tracking ID. A shipment is forgotten once it has been delivered for
+// longer than retention, and the oldest shipments are dropped early when
+// there are more than max of them, so the store does not grow without
+// bound.
+type shipmentStore struct {
+	retention time.Duration
+	max       int
+
+	mu        sync.RWMutex
+	shipments map[string]*shipment
+	// order holds tracking IDs from oldest to newest.
+	order []string
+}
+
+func newShipmentStore(retention time.Duration, max int) *shipmentStore {
+	return &shipmentStore{
+		retention: retention,
+		max:       max,
+		shipments: map[string]*shipment{},
+	}
+}
+
+// expired reports whether sh has been delivered for longer than the
+// retention period as of now.
+func (st *shipmentStore) expired(sh *shipment, now time.Time) bool {
+	return now.Sub(sh.deliverAt) > st.retention
+}
+
+// evict drops expired shipments from the front of the store, then the
+// oldest ones until there is room for one more. Shipments are created in
+// order but not delivered in order, so an expired shipment behind a live
+// one stays until the live one goes; get hides it meanwhile.
+func (st *shipmentStore) evict(now time.Time) {
+	n := 0
+	for n < len(st.order) {
+		sh := st.shipments[st.order[n]]
+		if !st.expired(sh, now) && len(st.order)-n < st.max {
+			break
+		}
+		delete(st.shipments, sh.trackingID)
+		n++
+	}
+	st.order = st.order[n:]
+}
+
+// create stores a new shipment under a fresh tracking ID and returns it.
+// It takes at least one business day to deliver, so that even a zero-day
+// rate shows the shipment in transit before it is delivered.
+func (st *shipmentStore) create(serviceLevel, warehouse string, items []*pb.CartItem, createdAt time.Time, transitDays int) (*shipment, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.evict(createdAt)
+
+	// IDs are random, so retry on the rare collision rather than hoping
+	// there is none.
+	for {
+		id, err := newTrackingID()
+		if err != nil {
+			return nil, err
+		}
+		if _, taken := st.shipments[id]; taken {
+			continue
+		}
+		sh := &shipment{
+			trackingID:   id,
+			serviceLevel: serviceLevel,
+			warehouse:    warehouse,
+			items:        items,
+			createdAt:    createdAt,
+			deliverAt:    addBusinessDays(createdAt, max(transitDays, 1)),
+		}
+		st.shipments[id] = sh
+		st.order = append(st.order, id)
+		return sh, nil
+	}
+}
+
+// get returns the shipment with trackingID unless it has expired as of now.
+func (st *shipmentStore) get(trackingID string, now time.Time) (*shipment, bool) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	sh, ok := st.shipments[trackingID]
+	if !ok || st.expired(sh, now) {
+		return nil, false
+	}
+	return sh, true
+}
+
+// trackingIDLetters excludes I and O, which are easily misread as 1 and 0.
+const trackingIDLetters = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+// newTrackingID returns an ID like "KX-4821-0937-5512" drawn from a
+// cryptographic source.
+func newTrackingID() (string, error) {
+	var letters [2]byte
+	for i := range letters {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(trackingIDLetters))))
+		if err != nil {
+			return "", err
+		}
+		letters[i] = trackingIDLetters[n.Int64()]
+	}
+	n, err := rand.Int(rand.Reader, big.NewInt(1e12))
+	if err != nil {
+		return "", err
+	}
+	digits := fmt.Sprintf("%012d", n.Int64())
+	return fmt.Sprintf("%s-%s-%s-%s", letters[:], digits[:4], digits[4:8], digits[8:]), nil
+}
+
+// toProto returns the shipment's state as of now.
+func (sh *shipment) toProto(now time.Time) *pb.Shipment {
+	events := []*pb.ShipmentEvent{{
+		Status:        pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED,
+		TimestampUnix: sh.createdAt.Unix(),
+		Description:   "Shipping label created",
+	}}
+	if pickup := sh.createdAt.Add(inTransitAfter); !now.Before(pickup) {
+		events = append(events, &pb.ShipmentEvent{
+			Status:        pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT,
+			TimestampUnix: pickup.Unix(),
+			Description:   "Picked up by carrier",
+		})
+	}
+	if !now.Before(sh.deliverAt) {
+		events = append(events, &pb.ShipmentEvent{
+			Status:        pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED,
+			TimestampUnix: sh.deliverAt.Unix(),
+			Description:   "Delivered",
+		})
+	}
+
+	return &pb.Shipment{
+		TrackingId:            sh.trackingID,
+		Status:                events[len(events)-1].GetStatus(),
+		ServiceLevel:          sh.serviceLevel,
+		EstimatedDeliveryDate: sh.deliverAt.Format("2006-01-02"),
+		Events:                events,
+		Items:                 sh.items,
+		Warehouse:             sh.warehouse,
+	}
+}
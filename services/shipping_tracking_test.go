@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testClock is a settable clock for ShippingService.now.
+type testClock struct{ t time.Time }
+
+func (c *testClock) now() time.Time          { return c.t }
+func (c *testClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func TestShipmentLifecycle(t *testing.T) {
+	// A Monday morning, so the next business day is the next day.
+	clock := &testClock{t: time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)}
+	s := &ShippingService{shipments: newShipmentStore(time.Hour, 10), now: clock.now}
+
+	// A zero-day rate still goes through pickup before delivery.
+	sh, err := s.shipments.create("express", "Reno", nil, clock.now(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	track := func() *pb.Shipment {
+		t.Helper()
+		resp, err := s.TrackShipment(context.Background(), &pb.TrackShipmentRequest{TrackingId: sh.trackingID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	for _, step := range []struct {
+		advance time.Duration
+		want    pb.ShipmentStatus
+		events  int
+	}{
+		{0, pb.ShipmentStatus_SHIPMENT_STATUS_LABEL_CREATED, 1},
+		{inTransitAfter, pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT, 2},
+		{20 * time.Hour, pb.ShipmentStatus_SHIPMENT_STATUS_IN_TRANSIT, 2},
+		{2 * time.Hour, pb.ShipmentStatus_SHIPMENT_STATUS_DELIVERED, 3},
+	} {
+		clock.advance(step.advance)
+		got := track()
+		if got.GetStatus() != step.want || len(got.GetEvents()) != step.events {
+			t.Errorf("at %s: status %v with %d events, want %v with %d",
+				clock.t.Format(time.RFC3339), got.GetStatus(), len(got.GetEvents()), step.want, step.events)
+		}
+		if got.GetEstimatedDeliveryDate() != "2024-03-05" {
+			t.Errorf("EstimatedDeliveryDate = %s, want 2024-03-05", got.GetEstimatedDeliveryDate())
+		}
+	}
+
+	// Delivered shipments are forgotten after the retention period.
+	clock.advance(time.Hour + time.Second)
+	_, err = s.TrackShipment(context.Background(), &pb.TrackShipmentRequest{TrackingId: sh.trackingID})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("TrackShipment after retention: err = %v, want NotFound", err)
+	}
+	if _, err := s.shipments.create("express", "Reno", nil, clock.now(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.shipments.shipments[sh.trackingID]; ok {
+		t.Errorf("expired shipment %s still stored", sh.trackingID)
+	}
+}
+
+func TestShipmentStoreCap(t *testing.T) {
+	now := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+	st := newShipmentStore(24*time.Hour, 3)
+
+	var ids []string
+	for i := 0; i < 5; i++ {
+		sh, err := st.create("standard", "Reno", nil, now, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, sh.trackingID)
+	}
+	if len(st.shipments) != 3 || len(st.order) != 3 {
+		t.Fatalf("store holds %d shipments (%d ordered), want 3", len(st.shipments), len(st.order))
+	}
+	for i, id := range ids {
+		if _, ok := st.get(id, now); ok != (i >= 2) {
+			t.Errorf("shipment %d stored = %v, want only the newest three", i, ok)
+		}
+	}
+}
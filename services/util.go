@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/opentracing/opentracing-go"
@@ -21,6 +22,29 @@ func mustMapEnv(target *string, envKey string) {
 	*target = v
 }
 
+// writeFileAtomic replaces the file at path with data. It writes and syncs
+// a temporary file in the same directory first, so that readers see either
+// the old file or the new one, never part of it.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
 func mustConnGRPC(_ context.Context, conn **grpc.ClientConn, addr string) {
 	log.Printf("Attempting to connect to gRPC server at: %s", addr)
 	var err error
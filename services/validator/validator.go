@@ -37,19 +37,42 @@ type Payload interface {
 type AddToCartPayload struct {
 	Quantity  uint64 `validate:"required,gte=1,lte=10"`
 	ProductID string `validate:"required"`
+	SKU       string `validate:"max=64"`
+}
+
+type WishlistItemPayload struct {
+	ProductID string `validate:"required"`
+	SKU       string `validate:"max=64"`
+}
+
+type AddReviewPayload struct {
+	Rating     int64  `validate:"required,gte=1,lte=5"`
+	AuthorName string `validate:"max=60"`
+	Title      string `validate:"max=120"`
+	Body       string `validate:"max=4000"`
+}
+
+type SubscribePayload struct {
+	Email string `validate:"required,email,max=254"`
+}
+
+type UnsubscribePayload struct {
+	Token string `validate:"required,hexadecimal,max=64"`
 }
 
 type PlaceOrderPayload struct {
 	Email         string `validate:"required,email"`
 	StreetAddress string `validate:"required,max=512"`
-	ZipCode       int64  `validate:"required"`
+	PostalCode    string `validate:"required,max=16"`
 	City          string `validate:"required,max=128"`
-	State         string `validate:"required,max=128"`
+	State         string `validate:"max=128"`
 	Country       string `validate:"required,max=128"`
 	CcNumber      string `validate:"required,credit_card"`
 	CcMonth       int64  `validate:"required,gte=1,lte=12"`
 	CcYear        int64  `validate:"required"`
-	CcCVV         int64  `validate:"required"`
+	CcCVV         string `validate:"required,number,min=3,max=4"`
+
+	ShippingServiceLevel string `validate:"omitempty,oneof=standard express overnight"`
 }
 
 type SetCurrencyPayload struct {
@@ -61,6 +84,22 @@ func (ad *AddToCartPayload) Validate() error {
 	return validate.Struct(ad)
 }
 
+func (wi *WishlistItemPayload) Validate() error {
+	return validate.Struct(wi)
+}
+
+func (ar *AddReviewPayload) Validate() error {
+	return validate.Struct(ar)
+}
+
+func (sp *SubscribePayload) Validate() error {
+	return validate.Struct(sp)
+}
+
+func (up *UnsubscribePayload) Validate() error {
+	return validate.Struct(up)
+}
+
 func (po *PlaceOrderPayload) Validate() error {
 	return validate.Struct(po)
 }
@@ -0,0 +1,240 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	"github.com/opentracing/opentracing-go"
+)
+
+const maxWishlistItems = 100
+
+// NewWishlistService returns a new server for the WishlistService
+func NewWishlistService(port int) *WishlistService {
+	return &WishlistService{
+		port: port,
+		now:  time.Now,
+	}
+}
+
+// WishlistService implements the WishlistService
+type WishlistService struct {
+	port int
+	pb.WishlistServiceServer
+
+	productCatalogSvcAddr string
+	productCatalogSvcConn *grpc.ClientConn
+
+	cartSvcAddr string
+	cartSvcConn *grpc.ClientConn
+
+	store wishlistStore
+	now   func() time.Time
+
+	// mu serializes read-modify-write updates of wishlists.
+	mu sync.Mutex
+}
+
+// Run starts the server
+func (s *WishlistService) Run() error {
+	ctx := context.Background()
+
+	mustMapEnv(&s.productCatalogSvcAddr, "PRODUCT_CATALOG_SERVICE_ADDR")
+	mustMapEnv(&s.cartSvcAddr, "CART_SERVICE_ADDR")
+	mustConnGRPC(ctx, &s.productCatalogSvcConn, s.productCatalogSvcAddr)
+	mustConnGRPC(ctx, &s.cartSvcConn, s.cartSvcAddr)
+
+	// Wishlists are kept in memory unless WISHLIST_REDIS_ADDR names a Redis
+	// to store them in.
+	s.store = newMemoryWishlistStore()
+	if addr := os.Getenv("WISHLIST_REDIS_ADDR"); addr != "" {
+		s.store = redisWishlistStore{rdb: redis.NewClient(&redis.Options{Addr: addr})}
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(otgrpc.OpenTracingServerInterceptor(opentracing.GlobalTracer())),
+	}
+	srv := grpc.NewServer(opts...)
+	pb.RegisterWishlistServiceServer(srv, s)
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+	log.Printf("WishlistService running at port: %d", s.port)
+	return srv.Serve(lis)
+}
+
+// AddItem adds a product in the catalog to the user's wishlist
+func (s *WishlistService) AddItem(ctx context.Context, req *pb.AddWishlistItemRequest) (*pb.Empty, error) {
+	log.Printf("AddItem request for user_id = %v, product_id = %v, sku = %v", req.GetUserId(), req.GetProductId(), req.GetSku())
+
+	if req.GetUserId() == "" || req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id and product_id are required")
+	}
+	p, err := pb.NewProductCatalogServiceClient(s.productCatalogSvcConn).
+		GetProduct(ctx, &pb.GetProductRequest{Id: req.GetProductId()})
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Errorf(codes.NotFound, "no product with ID %s", req.GetProductId())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to look up product %s: %v", req.GetProductId(), err)
+	}
+	if _, err := findVariant(p, req.GetSku()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items, err := s.get(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if wishlistIndex(items, req.GetProductId(), req.GetSku()) >= 0 {
+		return &pb.Empty{}, nil
+	}
+	if len(items) >= maxWishlistItems {
+		return nil, status.Errorf(codes.ResourceExhausted, "wishlist is limited to %d items", maxWishlistItems)
+	}
+	items = append(items, &pb.WishlistItem{
+		ProductId: req.GetProductId(),
+		Sku:       req.GetSku(),
+		AddedUnix: s.now().Unix(),
+	})
+	if err := s.put(ctx, req.GetUserId(), items); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+// RemoveItem removes an item from the user's wishlist. Removing an item that
+// is not there is not an error.
+func (s *WishlistService) RemoveItem(ctx context.Context, req *pb.RemoveWishlistItemRequest) (*pb.Empty, error) {
+	log.Printf("RemoveItem request for user_id = %v, product_id = %v, sku = %v", req.GetUserId(), req.GetProductId(), req.GetSku())
+
+	if req.GetUserId() == "" || req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id and product_id are required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.remove(ctx, req.GetUserId(), req.GetProductId(), req.GetSku()); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+// ListItems returns the user's wishlist, newest first
+func (s *WishlistService) ListItems(ctx context.Context, req *pb.ListWishlistRequest) (*pb.Wishlist, error) {
+	log.Printf("ListItems request for user_id = %v", req.GetUserId())
+
+	if req.GetUserId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id is required")
+	}
+	items, err := s.get(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.Wishlist{UserId: req.GetUserId(), Items: make([]*pb.WishlistItem, 0, len(items))}
+	for i := len(items) - 1; i >= 0; i-- {
+		resp.Items = append(resp.Items, items[i])
+	}
+	return resp, nil
+}
+
+// MoveToCart adds a wishlist item to the user's cart, then removes it from
+// the wishlist. s.mu is held throughout so that a repeated request cannot add
+// the item to the cart twice.
+func (s *WishlistService) MoveToCart(ctx context.Context, req *pb.MoveToCartRequest) (*pb.Empty, error) {
+	log.Printf("MoveToCart request for user_id = %v, product_id = %v, sku = %v, quantity = %v", req.GetUserId(), req.GetProductId(), req.GetSku(), req.GetQuantity())
+
+	if req.GetUserId() == "" || req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user_id and product_id are required")
+	}
+	quantity := req.GetQuantity()
+	if quantity < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "quantity must not be negative")
+	} else if quantity == 0 {
+		quantity = 1
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items, err := s.get(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if wishlistIndex(items, req.GetProductId(), req.GetSku()) < 0 {
+		return nil, status.Errorf(codes.NotFound, "product %s (SKU %q) is not on the wishlist", req.GetProductId(), req.GetSku())
+	}
+
+	if _, err := pb.NewCartServiceClient(s.cartSvcConn).AddItem(ctx, &pb.AddItemRequest{
+		UserId: req.GetUserId(),
+		Item: &pb.CartItem{
+			ProductId: req.GetProductId(),
+			Quantity:  quantity,
+			Sku:       req.GetSku(),
+		}}); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to add item to cart: %v", err)
+	}
+	if _, err := s.remove(ctx, req.GetUserId(), req.GetProductId(), req.GetSku()); err != nil {
+		// The item is in the cart; leaving it on the wishlist as well is
+		// the lesser evil.
+		log.Printf("Moved item to cart but failed to remove it from the wishlist of user_id = %v: %v", req.GetUserId(), err)
+	}
+	return &pb.Empty{}, nil
+}
+
+// remove drops an item from the user's wishlist and reports whether it was
+// there. s.mu must be held.
+func (s *WishlistService) remove(ctx context.Context, userID, productID, sku string) (bool, error) {
+	items, err := s.get(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	i := wishlistIndex(items, productID, sku)
+	if i < 0 {
+		return false, nil
+	}
+	items = append(items[:i:i], items[i+1:]...)
+	return true, s.put(ctx, userID, items)
+}
+
+func (s *WishlistService) get(ctx context.Context, userID string) ([]*pb.WishlistItem, error) {
+	items, err := s.store.Get(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to fetch wishlist for user_id = %v: %v", userID, err)
+		return nil, status.Errorf(codes.Unavailable, "failed to fetch wishlist")
+	}
+	return items, nil
+}
+
+func (s *WishlistService) put(ctx context.Context, userID string, items []*pb.WishlistItem) error {
+	if err := s.store.Put(ctx, userID, items); err != nil {
+		log.Printf("Failed to save wishlist for user_id = %v: %v", userID, err)
+		return status.Errorf(codes.Unavailable, "failed to save wishlist")
+	}
+	return nil
+}
+
+// wishlistIndex returns the index of the item with the given product and SKU,
+// or -1.
+func wishlistIndex(items []*pb.WishlistItem, productID, sku string) int {
+	for i, it := range items {
+		if it.GetProductId() == productID && it.GetSku() == sku {
+			return i
+		}
+	}
+	return -1
+}
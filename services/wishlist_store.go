@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// wishlistStore holds each user's wishlist. Get returns nil for a user with
+// no wishlist; Put with no items deletes it.
+type wishlistStore interface {
+	Get(ctx context.Context, userID string) ([]*pb.WishlistItem, error)
+	Put(ctx context.Context, userID string, items []*pb.WishlistItem) error
+}
+
+// memoryWishlistStore keeps wishlists for the life of the process.
+type memoryWishlistStore struct {
+	mu    sync.Mutex
+	items map[string][]*pb.WishlistItem
+}
+
+func newMemoryWishlistStore() *memoryWishlistStore {
+	return &memoryWishlistStore{items: map[string][]*pb.WishlistItem{}}
+}
+
+func (s *memoryWishlistStore) Get(_ context.Context, userID string) ([]*pb.WishlistItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.items[userID], nil
+}
+
+func (s *memoryWishlistStore) Put(_ context.Context, userID string, items []*pb.WishlistItem) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(items) == 0 {
+		delete(s.items, userID)
+	} else {
+		s.items[userID] = items
+	}
+	return nil
+}
+
+// redisWishlistStore stores each wishlist as JSON, like the cart. Keys are
+// prefixed so that the cart's Redis can be shared.
+type redisWishlistStore struct {
+	rdb *redis.Client
+}
+
+func wishlistKey(userID string) string { return "wishlist:" + userID }
+
+func (s redisWishlistStore) Get(ctx context.Context, userID string) ([]*pb.WishlistItem, error) {
+	data, err := s.rdb.Get(ctx, wishlistKey(userID)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var items []*pb.WishlistItem
+	if err := json.Unmarshal([]byte(data), &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (s redisWishlistStore) Put(ctx context.Context, userID string, items []*pb.WishlistItem) error {
+	if len(items) == 0 {
+		return s.rdb.Del(ctx, wishlistKey(userID)).Err()
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	return s.rdb.Set(ctx, wishlistKey(userID), data, 0).Err()
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/deskchen/online-boutique-grpc/protos/onlineboutique"
+)
+
+// TestWishlistFlow drives the wishlist pages through the frontend the way a
+// browser would: the session cookie set on the first visit must carry the
+// same wishlist and cart through every later request.
+func TestWishlistFlow(t *testing.T) {
+	catalog := &fakeCatalog{products: []*pb.Product{
+		{Id: "SUNGLASSES", Name: "Sunglasses", Picture: "/static/img/sunglasses.jpg", PriceUsd: usd(19, 990000000)},
+		{Id: "TANKTOP", Name: "Tank Top", Picture: "/static/img/tank.jpg", PriceUsd: usd(18, 990000000),
+			Variants: []*pb.ProductVariant{{Sku: "TANKTOP-M", Attributes: []*pb.VariantAttribute{{Name: "Size", Value: "M"}}}}},
+	}}
+	cart := newFakeCart()
+	catalogConn := dialServer(t, func(s *grpc.Server) { pb.RegisterProductCatalogServiceServer(s, catalog) })
+	cartConn := dialServer(t, func(s *grpc.Server) { pb.RegisterCartServiceServer(s, cart) })
+
+	wishlist := &WishlistService{
+		productCatalogSvcConn: catalogConn,
+		cartSvcConn:           cartConn,
+		store:                 newMemoryWishlistStore(),
+		now:                   time.Now,
+	}
+	fe := &frontendServer{
+		productCatalogSvcConn: catalogConn,
+		cartSvcConn:           cartConn,
+		currencySvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterCurrencyServiceServer(s, fakeCurrency{}) }),
+		wishlistSvcConn:       dialServer(t, func(s *grpc.Server) { pb.RegisterWishlistServiceServer(s, wishlist) }),
+	}
+	srv := httptest.NewServer(fe.handler())
+	defer srv.Close()
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Jar: jar}
+
+	get := func(path string) string {
+		t.Helper()
+		resp, err := client.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("GET %s: status %d\n%s", path, resp.StatusCode, body)
+		}
+		return string(body)
+	}
+	post := func(path string, form url.Values) string {
+		t.Helper()
+		resp, err := client.PostForm(srv.URL+path, form)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("POST %s: status %d\n%s", path, resp.StatusCode, body)
+		}
+		return string(body)
+	}
+
+	if body := get("/wishlist"); !strings.Contains(body, "Your wishlist is empty") {
+		t.Fatalf("new session's wishlist is not empty:\n%s", body)
+	}
+	u, _ := url.Parse(srv.URL)
+	var session string
+	for _, c := range jar.Cookies(u) {
+		if c.Name == cookieSessionID {
+			session = c.Value
+		}
+	}
+	if session == "" {
+		t.Fatal("no session cookie set")
+	}
+
+	post("/wishlist/add", url.Values{"product_id": {"SUNGLASSES"}})
+	body := post("/wishlist/add", url.Values{"product_id": {"TANKTOP"}, "sku": {"TANKTOP-M"}})
+	for _, want := range []string{"Wishlist (2)", "Sunglasses", "Tank Top", "Size: M"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("wishlist page lacks %q:\n%s", want, body)
+		}
+	}
+
+	body = post("/wishlist/move", url.Values{"product_id": {"TANKTOP"}, "sku": {"TANKTOP-M"}})
+	if !strings.Contains(body, "Wishlist (1)") || strings.Contains(body, "Tank Top") {
+		t.Errorf("moved item still on the wishlist:\n%s", body)
+	}
+	items := cart.items(session)
+	if len(items) != 1 || items[0].GetProductId() != "TANKTOP" || items[0].GetSku() != "TANKTOP-M" || items[0].GetQuantity() != 1 {
+		t.Errorf("cart for session %s = %v, want one TANKTOP-M", session, items)
+	}
+
+	if body := post("/wishlist/remove", url.Values{"product_id": {"SUNGLASSES"}}); !strings.Contains(body, "Your wishlist is empty") {
+		t.Errorf("removed item still on the wishlist:\n%s", body)
+	}
+
+	// A second browser gets its own session and so its own wishlist.
+	other := &http.Client{}
+	resp, err := other.PostForm(srv.URL+"/wishlist/add", url.Values{"product_id": {"SUNGLASSES"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if got, _ := wishlist.store.Get(context.Background(), session); len(got) != 0 {
+		t.Errorf("another session's item landed on this wishlist: %v", got)
+	}
+}